@@ -2,11 +2,14 @@ package app
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
+	"github.com/joyfuldevs/project-jarvis/pkg/admin"
 	"github.com/joyfuldevs/project-jarvis/service/dataportal/server"
 )
 
@@ -16,15 +19,42 @@ func Run() {
 		slog.Error("no such DATA_PORTAL_AUTH_KEY")
 		return
 	}
+	adminAddr := admin.Addr()
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	metrics := NewMetrics()
 	server := server.NewServer(
-		server.WithServiceV1(NewDataPortalService(key)),
+		server.WithServiceV1(NewDataPortalService(key, metrics)),
+	)
+	// 공공데이터포털의 장애는 /metrics 의 호출 결과로 확인하고, 준비 상태는 gRPC 서버만 확인한다.
+	adminServer := admin.NewServer(
+		admin.WithRegistry(metrics.Registry),
+		admin.WithReadyCheck("grpc", func() error {
+			if !server.Serving() {
+				return errors.New("not serving")
+			}
+			return nil
+		}),
 	)
 
-	if err := server.Serve(ctx); err != nil {
-		slog.Error("failed to start data portal service", slog.Any("error", err))
-	}
+	wg := sync.WaitGroup{}
+
+	wg.Go(func() {
+		if err := server.Serve(ctx); err != nil {
+			slog.Error("failed to start data portal service", slog.Any("error", err))
+		}
+		stop()
+	})
+
+	wg.Go(func() {
+		slog.Info("starting admin server", slog.String("addr", adminAddr))
+		if err := adminServer.Serve(ctx, adminAddr); err != nil {
+			slog.Error("failed to run admin server", slog.Any("error", err))
+		}
+		stop()
+	})
+
+	wg.Wait()
 }
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/metrics"
+)
+
+// 공공데이터포털 API 호출에 대한 지표.
+type Metrics struct {
+	Registry *metrics.Registry
+
+	upstreamCalls        *metrics.CounterVec
+	upstreamCallDuration *metrics.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	registry := metrics.NewRegistry()
+	return &Metrics{
+		Registry: registry,
+		upstreamCalls: registry.NewCounterVec(
+			"dataportal_upstream_calls_total",
+			"Number of public data portal API calls by operation and status.",
+			"operation", "status",
+		),
+		upstreamCallDuration: registry.NewHistogramVec(
+			"dataportal_upstream_call_duration_seconds",
+			"Time spent calling public data portal API by operation.",
+			nil,
+			"operation",
+		),
+	}
+}
+
+// 요청마다 호출 결과를 기록하도록 client 를 감싼 클라이언트를 반환한다.
+func (m *Metrics) instrument(client *http.Client) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	instrumented := *client
+	instrumented.Transport = &metricsTransport{next: next, metrics: m}
+	return &instrumented
+}
+
+// 공공데이터포털 API 호출을 기록하는 RoundTripper.
+type metricsTransport struct {
+	next    http.RoundTripper
+	metrics *Metrics
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// 서비스 키가 포함된 쿼리는 제외하고, 경로의 마지막 부분을 오퍼레이션 이름으로 사용한다. (e.g. "getRestDeInfo")
+	operation := path.Base(req.URL.Path)
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	t.metrics.upstreamCallDuration.ObserveDuration(time.Since(start), operation)
+
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	t.metrics.upstreamCalls.Inc(operation, status)
+	return resp, err
+}
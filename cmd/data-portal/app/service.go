@@ -9,8 +9,12 @@ import (
 type DataPortalService struct {
 }
 
-func NewDataPortalService(authKey string) *DataPortalService {
+// 공공데이터포털 API 를 호출하는 서비스를 만든다. metrics 가 nil 이 아니면 API 호출을 기록한다.
+func NewDataPortalService(authKey string, metrics *Metrics) *DataPortalService {
 	c := publicapi.NewClient(authKey)
+	if metrics != nil {
+		c.HTTPClient = metrics.instrument(c.HTTPClient)
+	}
 	specialday.SetDefaultClient(c)
 	forecast.SetDefaultClient(c)
 	return &DataPortalService{}
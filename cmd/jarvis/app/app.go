@@ -5,17 +5,30 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
 	"github.com/joyfuldevs/project-jarvis/service/jarvis/server"
 )
 
+// 슬랙 이벤트를 전달받는 방식.
+const (
+	// 소켓 모드로 슬랙에 연결한다. 별도의 공개 주소가 필요없다.
+	TransportSocket = "socket"
+	// 슬랙이 HTTP 로 보내는 요청을 받는다. 여러 인스턴스를 로드 밸런서 뒤에서 실행할 수 있다.
+	TransportHTTP = "http"
+)
+
+// HTTP 모드에서 SLACK_HTTP_ADDR 가 없을 때 사용하는 주소.
+const DefaultHTTPAddr = ":3000"
+
 func Run() {
-	appToken, ok := os.LookupEnv("SLACK_APP_TOKEN")
+	transport, ok := os.LookupEnv("SLACK_TRANSPORT")
 	if !ok {
-		slog.Error("no such SLACK_APP_TOKEN")
-		return
+		transport = TransportSocket
 	}
 	botToken, ok := os.LookupEnv("SLACK_BOT_TOKEN")
 	if !ok {
@@ -23,17 +36,69 @@ func Run() {
 		return
 	}
 
+	metrics := NewMetrics()
+	// 봇과 gRPC 서비스는 같은 봇 토큰을 사용하므로 요청 제한을 함께 관리해야 한다.
+	slackOpts := []slack.Option{
+		metrics.SlackOption(),
+		slack.WithRateLimiter(slack.NewRateLimiter()),
+	}
+	var (
+		appToken  string
+		jarvisBot *JarvisBot
+		runBot    func(ctx context.Context) error
+	)
+	switch transport {
+	case TransportSocket:
+		appToken, ok = os.LookupEnv("SLACK_APP_TOKEN")
+		if !ok {
+			slog.Error("no such SLACK_APP_TOKEN")
+			return
+		}
+		jarvisBot = NewJarvisBot(appToken, botToken, slackOpts...)
+		// 장애를 재현할 수 있도록 받은 envelope 을 파일에 기록한다.
+		if path, ok := os.LookupEnv("SLACK_RECORD_FILE"); ok {
+			file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+			if err != nil {
+				slog.Error("failed to open record file", slog.Any("error", err))
+				return
+			}
+			defer func() { _ = file.Close() }()
+			jarvisBot.Recorder = bot.NewRecorder(file)
+		}
+		runBot = jarvisBot.Run
+	case TransportHTTP:
+		signingSecret, ok := os.LookupEnv("SLACK_SIGNING_SECRET")
+		if !ok {
+			slog.Error("no such SLACK_SIGNING_SECRET")
+			return
+		}
+		addr, ok := os.LookupEnv("SLACK_HTTP_ADDR")
+		if !ok {
+			addr = DefaultHTTPAddr
+		}
+		jarvisBot = NewJarvisHTTPBot(signingSecret, botToken, slackOpts...)
+		runBot = func(ctx context.Context) error {
+			return jarvisBot.RunHTTP(ctx, addr)
+		}
+	default:
+		slog.Error("unknown SLACK_TRANSPORT", slog.String("transport", transport))
+		return
+	}
+	jarvisBot.Allow, jarvisBot.Deny = accessListsFromEnv()
+	jarvisBot.Metrics = metrics
+
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	jarvisService := NewJarvisService(appToken, botToken, slackOpts...)
+	jarvisServer := server.NewServer(
+		server.WithServiceV1(jarvisService),
+	)
+
 	wg := sync.WaitGroup{}
 
 	wg.Go(func() {
 		slog.Info("starting jarvis service")
-		jarvisService := NewJarvisService(appToken, botToken)
-		jarvisServer := server.NewServer(
-			server.WithServiceV1(jarvisService),
-		)
 		if err := jarvisServer.Serve(ctx); err != nil {
 			slog.Error("failed to run service", slog.Any("error", err))
 		}
@@ -41,13 +106,48 @@ func Run() {
 	})
 
 	wg.Go(func() {
-		slog.Info("starting jarvis bot")
-		jarvisBot := NewJarvisBot(appToken, botToken)
-		if err := jarvisBot.Run(ctx); err != nil {
+		jarvisService.RunDirectory(ctx)
+	})
+
+	wg.Go(func() {
+		slog.Info("starting jarvis bot", slog.String("transport", transport))
+		if err := runBot(ctx); err != nil {
 			slog.Error("failed to run bot", slog.Any("error", err))
 		}
 		stop()
 	})
 
+	wg.Go(func() {
+		if err := runAdmin(ctx, metrics, jarvisBot, jarvisServer.Serving); err != nil {
+			slog.Error("failed to run admin server", slog.Any("error", err))
+		}
+		stop()
+	})
+
 	wg.Wait()
 }
+
+// 봇을 사용할 수 있는 사용자와 채널을 환경 변수에서 읽는다. ID 는 쉼표로 구분한다.
+// SLACK_ALLOWED_USERS, SLACK_ALLOWED_CHANNELS 가 비어있으면 모두 허용한다.
+func accessListsFromEnv() (allow bot.AccessList, deny bot.AccessList) {
+	allow = bot.AccessList{
+		Users:    splitIDs(os.Getenv("SLACK_ALLOWED_USERS")),
+		Channels: splitIDs(os.Getenv("SLACK_ALLOWED_CHANNELS")),
+	}
+	deny = bot.AccessList{
+		Users:    splitIDs(os.Getenv("SLACK_DENIED_USERS")),
+		Channels: splitIDs(os.Getenv("SLACK_DENIED_CHANNELS")),
+	}
+	return allow, deny
+}
+
+// 쉼표로 구분한 ID 목록을 나눈다. (e.g. "U1, U2")
+func splitIDs(s string) []string {
+	var ids []string
+	for id := range strings.SplitSeq(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
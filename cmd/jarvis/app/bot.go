@@ -2,44 +2,146 @@ package app
 
 import (
 	"context"
+	"net"
+	"sync/atomic"
+	"time"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/slack"
 	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
 )
 
-var _ bot.EventHandler = (*JarvisBot)(nil)
+var (
+	_ bot.EventHandler      = (*JarvisBot)(nil)
+	_ bot.EventsAPIHandler  = (*JarvisBot)(nil)
+	_ bot.CommandAckHandler = (*JarvisBot)(nil)
+	_ bot.ShortcutHandler   = (*JarvisBot)(nil)
+)
+
+// 사용자 한 명이 1분 동안 요청할 수 있는 이벤트의 수.
+const userRateLimit = 20
 
 type JarvisBot struct {
 	AppToken string
 	BotToken string
+	// 이벤트를 처리할 사용자와 채널. 비어있으면 모두 처리한다.
+	Allow bot.AccessList
+	// 이벤트를 처리하지 않을 사용자와 채널.
+	Deny bot.AccessList
+	// 소켓 모드로 받은 envelope 을 기록한다. nil 이면 기록하지 않는다.
+	Recorder *bot.Recorder
+	// 받은 envelope 과 핸들러의 처리 시간을 기록한다. nil 이면 기록하지 않는다.
+	Metrics *Metrics
+
+	signingSecret string
+	// 웹소켓 연결과 메시지 응답에서 하나의 클라이언트를 공유한다.
+	client *slack.Client
+	router *bot.Router
+	// 실행중인 봇. 실행하기 전에는 nil 이다.
+	bot atomic.Pointer[bot.Bot]
 }
 
-func NewJarvisBot(appToken, botToken string) *JarvisBot {
+// 소켓 모드로 이벤트를 전달받는 봇을 만든다.
+func NewJarvisBot(appToken, botToken string, opts ...slack.Option) *JarvisBot {
+	client := slack.NewClient(appToken, botToken, opts...)
 	return &JarvisBot{
 		AppToken: appToken,
 		BotToken: botToken,
+		client:   client,
+		router:   newRouter(client),
+	}
+}
+
+// HTTP 로 이벤트를 전달받는 봇을 만든다. 요청은 signingSecret 으로 서명을 확인한다.
+func NewJarvisHTTPBot(signingSecret, botToken string, opts ...slack.Option) *JarvisBot {
+	client := slack.NewClient("", botToken, opts...)
+	return &JarvisBot{
+		BotToken:      botToken,
+		signingSecret: signingSecret,
+		client:        client,
+		router:        newRouter(client),
 	}
 }
 
+// 소켓 모드로 슬랙에 연결해 이벤트를 처리한다.
 func (j *JarvisBot) Run(ctx context.Context) error {
-	bot := bot.NewBot(j.AppToken, j.BotToken, j)
-	return bot.Run(ctx)
+	// 배포나 연결 갱신 중에도 이벤트를 놓치지 않도록 두 개의 연결을 유지한다.
+	return j.newBot(bot.WithConnections(2)).Run(ctx)
+}
+
+// addr 에서 슬랙이 HTTP 로 보내는 이벤트를 처리한다. (e.g. ":3000")
+func (j *JarvisBot) RunHTTP(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return j.newBot(bot.WithSigningSecret(j.signingSecret)).RunHTTP(ctx, listener)
+}
+
+// 실행할 봇을 만든다. 모든 핸들러에 공통으로 적용할 미들웨어는 여기에서 한 번만 구성한다.
+func (j *JarvisBot) newBot(opts ...bot.Option) *bot.Bot {
+	opts = append([]bot.Option{
+		bot.WithClient(j.client),
+		bot.WithMiddleware(
+			bot.Logging(),
+			bot.Deny(j.Deny),
+			bot.Allow(j.Allow),
+			bot.RateLimit(userRateLimit, time.Minute),
+		),
+	}, opts...)
+	if j.Recorder != nil {
+		opts = append(opts, bot.WithRecorder(j.Recorder))
+	}
+	if j.Metrics != nil {
+		// 거절한 이벤트까지 처리 시간에 포함하도록 가장 바깥쪽에서 측정한다.
+		opts = append(j.Metrics.botOptions(), opts...)
+	}
+	b := bot.NewBot(j.AppToken, j.BotToken, j, opts...)
+	j.bot.Store(b)
+	return b
 }
 
-func (j *JarvisBot) HandleCommandEvent(payload *slack.SlashCommandEventPayload) {
-	responder := &CommandResponder{
-		AppToken: j.AppToken,
-		BotToken: j.BotToken,
-		Payload:  payload,
+// 소켓 모드 연결 상태를 반환한다.
+func (j *JarvisBot) State() bot.ConnectionState {
+	if b := j.bot.Load(); b != nil {
+		return b.State()
 	}
-	responder.RespondCommand()
+	return bot.StateClosed
+}
+
+func (j *JarvisBot) HandleCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) {
+	j.router.HandleCommandEvent(ctx, payload)
+}
+
+func (j *JarvisBot) AckCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) *slack.InteractiveResponsePayload {
+	return j.router.AckCommandEvent(ctx, payload)
+}
+
+func (j *JarvisBot) HandleInteractiveEvent(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	j.router.HandleInteractiveEvent(ctx, payload)
+}
+
+func (j *JarvisBot) HandleShortcut(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	j.router.HandleShortcut(ctx, payload)
+}
+
+func (j *JarvisBot) HandleMessageShortcut(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	j.router.HandleMessageShortcut(ctx, payload)
 }
 
-func (j *JarvisBot) HandleInteractiveEvent(payload *slack.InteractiveEventPayload) {
-	responder := &ActionResponder{
-		AppToken: j.AppToken,
-		BotToken: j.BotToken,
-		Payload:  payload,
+func (j *JarvisBot) HandleEventsAPIEvent(ctx context.Context, payload *slack.EventsAPIPayload) {
+	switch e := payload.Event.(type) {
+	case *slack.AppMentionEvent:
+		// 멘션에는 스레드로 응답한다.
+		thread := e.ThreadTimestamp
+		if thread == "" {
+			thread = e.Timestamp
+		}
+		j.router.Dispatch(ctx, e.User, &bot.CommandMessage{Channel: e.Channel, ThreadTimestamp: thread}, e.Text)
+	case *slack.MessageEvent:
+		// 봇과의 DM 으로 전달된 사용자 메시지에만 응답한다. 슬래시 커맨드와 같은 라우터로 처리한다.
+		if !e.IsDirectMessage() || e.BotID != "" || e.SubType != "" {
+			return
+		}
+		j.router.Dispatch(ctx, e.User, &bot.CommandMessage{Channel: e.Channel, ThreadTimestamp: e.ThreadTimestamp}, e.Text)
 	}
-	responder.RespondActions()
 }
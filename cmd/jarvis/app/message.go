@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
 	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
 	dataportal "github.com/joyfuldevs/project-jarvis/service/dataportal/client"
 )
 
@@ -46,7 +50,8 @@ func makeErrorMessage(err error) []blockkit.SlackBlock {
 	return blocks
 }
 
-func makeGuideMessage() []blockkit.SlackBlock {
+// 잘못된 명령어를 안내한다. suggestion 이 있으면 비슷한 커맨드를 함께 보여준다.
+func makeGuideMessage(suggestion string) []blockkit.SlackBlock {
 	blocks := make([]blockkit.SlackBlock, 0, 4)
 	blocks = append(blocks,
 		&blockkit.HeaderBlock{
@@ -56,6 +61,16 @@ func makeGuideMessage() []blockkit.SlackBlock {
 				Emoji: true,
 			},
 		},
+	)
+	if suggestion != "" {
+		blocks = append(blocks, &blockkit.SectionBlock{
+			Text: &blockkit.TextObject{
+				Type: blockkit.TextTypeMarkdown,
+				Text: fmt.Sprintf("혹시 `/자비스 %s` 를 입력하려고 하셨나요?", suggestion),
+			},
+		})
+	}
+	blocks = append(blocks,
 		&blockkit.DividerBlock{},
 		&blockkit.ActionBlock{
 			Elements: []blockkit.SlackBlockElement{
@@ -82,8 +97,10 @@ func makeGuideMessage() []blockkit.SlackBlock {
 	return blocks
 }
 
-func makeManualMessage() []blockkit.SlackBlock {
-	return []blockkit.SlackBlock{
+// 라우터에 등록된 커맨드로 지원 기능 안내를 만든다.
+func makeManualMessage(commands []bot.CommandInfo) []blockkit.SlackBlock {
+	blocks := make([]blockkit.SlackBlock, 0, len(commands)*2+3)
+	blocks = append(blocks,
 		&blockkit.HeaderBlock{
 			Text: blockkit.TextObject{
 				Type:  blockkit.TextTypePlainText,
@@ -92,39 +109,35 @@ func makeManualMessage() []blockkit.SlackBlock {
 			},
 		},
 		&blockkit.DividerBlock{},
-		&blockkit.SectionBlock{
-			Text: &blockkit.TextObject{
-				Type: blockkit.TextTypeMarkdown,
-				Text: fmt.Sprintf("*공휴일 안내*    `/자비스 %s`", CommandHolidayCalendar),
-			},
-			Accessory: &blockkit.ButtonElement{
-				ActionID: ButtonActionHolidayCalendar,
-				Text: blockkit.TextObject{
-					Type: blockkit.TextTypePlainText,
-					Text: "실행",
-				},
-			},
-		},
-		&blockkit.DividerBlock{},
-		&blockkit.SectionBlock{
+	)
+
+	for _, command := range commands {
+		usage := "/자비스 " + command.Name
+		if command.Usage != "" {
+			usage += " " + command.Usage
+		}
+		section := &blockkit.SectionBlock{
 			Text: &blockkit.TextObject{
 				Type: blockkit.TextTypeMarkdown,
-				Text: fmt.Sprintf("*초단기 날씨 예보*    `/자비스 %s`", CommandForecast),
+				Text: fmt.Sprintf("*%s*    `%s`", command.Description, usage),
 			},
-			Accessory: &blockkit.ButtonElement{
-				ActionID: ButtonActionForecast,
+		}
+		if command.ActionID != "" {
+			section.Accessory = &blockkit.ButtonElement{
+				ActionID: command.ActionID,
 				Text: blockkit.TextObject{
 					Type: blockkit.TextTypePlainText,
 					Text: "실행",
 				},
-			},
-		},
-		&blockkit.DividerBlock{},
-		makeDoneButton(),
+			}
+		}
+		blocks = append(blocks, section, &blockkit.DividerBlock{})
 	}
+
+	return append(blocks, makeDoneButton())
 }
 
-func makeHolidayCalendarMessage() []blockkit.SlackBlock {
+func makeHolidayCalendarMessage(ctx context.Context) []blockkit.SlackBlock {
 	getCalendar := func(year, month int) map[int]string {
 		client, err := dataportal.NewClient()
 		if err != nil {
@@ -133,7 +146,7 @@ func makeHolidayCalendarMessage() []blockkit.SlackBlock {
 		}
 		defer func() { _ = client.Close() }()
 
-		holidays, err := client.ListHolidays(context.Background(), year, int(month))
+		holidays, err := client.ListHolidays(ctx, year, int(month))
 		if err != nil {
 			slog.Error("failed to get holiday calendar", slog.Any("error", err))
 			return nil
@@ -166,6 +179,7 @@ func makeHolidayCalendarMessage() []blockkit.SlackBlock {
 		&blockkit.SectionBlock{
 			Fields: fields,
 		},
+		blockkit.NewContextBlock("📡 출처: 한국천문연구원 특일 정보"),
 		&blockkit.DividerBlock{},
 		makeDoneButton(),
 	}
@@ -196,25 +210,30 @@ func makeHolidayField(year, month int, calendar map[int]string) *blockkit.TextOb
 	}
 }
 
-func makeForecastMessage() []blockkit.SlackBlock {
+func makeForecastMessage(ctx context.Context, region Region) []blockkit.SlackBlock {
 	client, err := dataportal.NewClient()
 	if err != nil {
 		slog.Error("failed to create client", slog.Any("error", err))
 		return makeErrorMessage(err)
 	}
 
-	resp, err := client.GetUltraShortTermForecast(context.Background(), 60, 123)
+	resp, err := client.GetUltraShortTermForecast(ctx, region.NX, region.NY)
 	if err != nil {
 		slog.Error("failed to get ultra short term forecast", slog.Any("error", err))
 		return makeErrorMessage(err)
 	}
 
-	blocks := make([]blockkit.SlackBlock, 0, len(resp)+4)
+	title := "🌤️ 날씨 정보"
+	if region.Name != "" {
+		title = fmt.Sprintf("🌤️ %s 날씨 정보", region.Name)
+	}
+
+	blocks := make([]blockkit.SlackBlock, 0, len(resp)+6)
 	blocks = append(blocks,
 		&blockkit.HeaderBlock{
 			Text: blockkit.TextObject{
 				Type:  blockkit.TextTypePlainText,
-				Text:  "🌤️ 날씨 정보",
+				Text:  title,
 				Emoji: true,
 			},
 		},
@@ -242,6 +261,8 @@ func makeForecastMessage() []blockkit.SlackBlock {
 	}
 
 	blocks = append(blocks,
+		blockkit.NewContextBlock(fmt.Sprintf("📡 출처: 기상청 초단기예보 · %s 기준", kst.Now().Format("15:04"))),
+		makeRegionSelect(region),
 		&blockkit.DividerBlock{},
 		makeDoneButton(),
 	)
@@ -249,6 +270,38 @@ func makeForecastMessage() []blockkit.SlackBlock {
 	return blocks
 }
 
+func makeRegionSelect(region Region) blockkit.SlackBlock {
+	options := make([]blockkit.OptionBlockObject, 0, len(Regions))
+	for _, r := range Regions {
+		options = append(options, blockkit.OptionBlockObject{
+			Text: blockkit.TextObject{
+				Type: blockkit.TextTypePlainText,
+				Text: r.Name,
+			},
+			Value: r.Name,
+		})
+	}
+
+	element := &blockkit.SelectElement{
+		ActionID: SelectActionForecastRegion,
+		Placeholder: blockkit.TextObject{
+			Type:  blockkit.TextTypePlainText,
+			Text:  "📍 지역 선택",
+			Emoji: true,
+		},
+		Options: options,
+	}
+	for i := range options {
+		if options[i].Value == region.Name {
+			element.InitialOption = &options[i]
+		}
+	}
+
+	return &blockkit.ActionBlock{
+		Elements: []blockkit.SlackBlockElement{element},
+	}
+}
+
 func makeDoneButton() blockkit.SlackBlock {
 	return &blockkit.ActionBlock{
 		Elements: []blockkit.SlackBlockElement{
@@ -263,3 +316,132 @@ func makeDoneButton() blockkit.SlackBlock {
 		},
 	}
 }
+
+// 요청한 사용자에게 결과를 짧게 알린다.
+func makeNoticeMessage(text string) []blockkit.SlackBlock {
+	return []blockkit.SlackBlock{
+		&blockkit.SectionBlock{
+			Text: &blockkit.TextObject{
+				Type:  blockkit.TextTypePlainText,
+				Text:  text,
+				Emoji: true,
+			},
+		},
+	}
+}
+
+// 스레드 요약과 리마인드에서 메시지 한 줄에 보여줄 최대 글자 수.
+const summaryLineLength = 80
+
+// 리마인드로 다시 보낼 메시지. 원본 메시지를 인용하고 링크를 함께 보여준다.
+func makeRemindMessage(message *slack.MessageObject, permalink string) []blockkit.SlackBlock {
+	quote := "> " + strings.ReplaceAll(strings.TrimSpace(message.Text), "\n", "\n> ")
+	footer := fmt.Sprintf("<@%s> 님의 메시지", message.User)
+	if permalink != "" {
+		footer += fmt.Sprintf(" · <%s|원본 보기>", permalink)
+	}
+
+	return []blockkit.SlackBlock{
+		&blockkit.HeaderBlock{
+			Text: blockkit.TextObject{
+				Type:  blockkit.TextTypePlainText,
+				Text:  "🔔 리마인드",
+				Emoji: true,
+			},
+		},
+		&blockkit.SectionBlock{
+			Text: &blockkit.TextObject{
+				Type: blockkit.TextTypeMarkdown,
+				Text: truncate(quote, 2900),
+			},
+		},
+		blockkit.NewContextBlock(footer),
+	}
+}
+
+// 스레드의 참여자, 메시지 수, 기간과 앞부분의 메시지를 요약한다.
+// messages 는 스레드의 첫 메시지부터 오래된 순서로 정렬되어 있어야 한다.
+func makeThreadSummaryMessage(channel string, messages []slack.MessageObject, permalink string) []blockkit.SlackBlock {
+	const maxLines = 10
+
+	var participants []string
+	for _, message := range messages {
+		if mention := fmt.Sprintf("<@%s>", message.User); message.User != "" && !slices.Contains(participants, mention) {
+			participants = append(participants, mention)
+		}
+	}
+	overview := fmt.Sprintf("*채널*    <#%s>\n*메시지*    %d개\n*참여자*    %s",
+		channel, len(messages), strings.Join(participants, ", "))
+	if len(messages) > 0 {
+		first := kst.KST(timestampTime(messages[0].Timestamp))
+		last := kst.KST(timestampTime(messages[len(messages)-1].Timestamp))
+		overview += fmt.Sprintf("\n*기간*    %s ~ %s", first.Format("2006-01-02 15:04"), last.Format("2006-01-02 15:04"))
+	}
+
+	lines := make([]string, 0, maxLines)
+	for _, message := range messages[:min(len(messages), maxLines)] {
+		lines = append(lines, fmt.Sprintf("• <@%s> %s", message.User, summarizeLine(message.Text)))
+	}
+
+	blocks := []blockkit.SlackBlock{
+		&blockkit.HeaderBlock{
+			Text: blockkit.TextObject{
+				Type:  blockkit.TextTypePlainText,
+				Text:  "🧵 스레드 요약",
+				Emoji: true,
+			},
+		},
+		&blockkit.SectionBlock{
+			Text: &blockkit.TextObject{
+				Type: blockkit.TextTypeMarkdown,
+				Text: truncate(overview, 2900),
+			},
+		},
+		&blockkit.DividerBlock{},
+	}
+	if len(lines) > 0 {
+		blocks = append(blocks, &blockkit.SectionBlock{
+			Text: &blockkit.TextObject{
+				Type: blockkit.TextTypeMarkdown,
+				Text: strings.Join(lines, "\n"),
+			},
+		})
+	}
+
+	var footer []string
+	if remains := len(messages) - len(lines); remains > 0 {
+		footer = append(footer, fmt.Sprintf("외 %d개의 메시지", remains))
+	}
+	if permalink != "" {
+		footer = append(footer, fmt.Sprintf("<%s|스레드 열기>", permalink))
+	}
+	if len(footer) > 0 {
+		blocks = append(blocks, blockkit.NewContextBlock(strings.Join(footer, " · ")))
+	}
+	return blocks
+}
+
+// 메시지의 첫 줄을 summaryLineLength 글자까지 자른다.
+func summarizeLine(text string) string {
+	line, _, multiline := strings.Cut(strings.TrimSpace(text), "\n")
+	if multiline {
+		line += " …"
+	}
+	return truncate(line, summaryLineLength)
+}
+
+// 문자열을 최대 n 글자까지 자르고, 잘린 경우 말줄임표를 붙인다.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// 슬랙 메시지의 ts 를 초 단위의 시간으로 변환한다. (e.g. "1503435956.000240")
+func timestampTime(ts string) time.Time {
+	seconds, _, _ := strings.Cut(ts, ".")
+	unix, _ := strconv.ParseInt(seconds, 10, 64)
+	return time.Unix(unix, 0)
+}
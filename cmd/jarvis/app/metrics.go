@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/admin"
+	"github.com/joyfuldevs/project-jarvis/pkg/metrics"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+)
+
+// 봇과 슬랙 API 호출에 대한 지표.
+type Metrics struct {
+	Registry *metrics.Registry
+
+	envelopes         *metrics.CounterVec
+	droppedJobs       *metrics.CounterVec
+	handlerDuration   *metrics.HistogramVec
+	slackCalls        *metrics.CounterVec
+	slackCallDuration *metrics.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	registry := metrics.NewRegistry()
+	return &Metrics{
+		Registry: registry,
+		envelopes: registry.NewCounterVec(
+			"jarvis_envelopes_received_total",
+			"Number of Slack envelopes received by kind.",
+			"kind", "duplicated",
+		),
+		droppedJobs: registry.NewCounterVec(
+			"jarvis_jobs_dropped_total",
+			"Number of Slack events dropped because all workers were busy, by kind.",
+			"kind",
+		),
+		handlerDuration: registry.NewHistogramVec(
+			"jarvis_handler_duration_seconds",
+			"Time spent handling Slack events by kind.",
+			nil,
+			"kind",
+		),
+		slackCalls: registry.NewCounterVec(
+			"jarvis_slack_api_calls_total",
+			"Number of Slack Web API calls by method and status.",
+			"method", "status",
+		),
+		slackCallDuration: registry.NewHistogramVec(
+			"jarvis_slack_api_call_duration_seconds",
+			"Time spent calling Slack Web API by method, including retries.",
+			nil,
+			"method",
+		),
+	}
+}
+
+// 슬랙 API 호출을 기록하는 클라이언트 옵션.
+func (m *Metrics) SlackOption() slack.Option {
+	return slack.WithCallObserver(func(method string, status string, duration time.Duration) {
+		m.slackCalls.Inc(method, status)
+		m.slackCallDuration.ObserveDuration(duration, method)
+	})
+}
+
+// 받은 envelope 과 버린 작업, 핸들러의 처리 시간을 기록하는 봇 옵션.
+func (m *Metrics) botOptions() []bot.Option {
+	return []bot.Option{
+		bot.WithEnvelopeObserver(func(kind bot.EventKind, duplicated bool) {
+			m.envelopes.Inc(string(kind), strconv.FormatBool(duplicated))
+		}),
+		bot.WithDropObserver(func(kind bot.EventKind) {
+			m.droppedJobs.Inc(string(kind))
+		}),
+		bot.WithMiddleware(bot.Timing(func(e *bot.Event, duration time.Duration) {
+			m.handlerDuration.ObserveDuration(duration, string(e.Kind))
+		})),
+	}
+}
+
+// 봇이 슬랙에 연결되어 있고 gRPC 서버가 요청을 받을 수 있어야 준비된 것으로 본다.
+func runAdmin(ctx context.Context, m *Metrics, jarvisBot *JarvisBot, serving func() bool) error {
+	server := admin.NewServer(
+		admin.WithRegistry(m.Registry),
+		admin.WithReadyCheck("slack", func() error {
+			if state := jarvisBot.State(); state != bot.StateConnected {
+				return fmt.Errorf("bot is %s", state)
+			}
+			return nil
+		}),
+		admin.WithReadyCheck("grpc", func() error {
+			if !serving() {
+				return errors.New("not serving")
+			}
+			return nil
+		}),
+	)
+	addr := admin.Addr()
+	slog.Info("starting admin server", slog.String("addr", addr))
+	return server.Serve(ctx, addr)
+}
@@ -1,185 +1,147 @@
 package app
 
 import (
-	"bytes"
-	"encoding/base64"
-	"encoding/json"
-	"errors"
-	"io"
+	"context"
 	"log/slog"
-	"net/http"
-	"strings"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/slack"
-	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
 )
 
-type CommandResponder struct {
-	AppToken string
-	BotToken string
-	Payload  *slack.SlashCommandEventPayload
-}
-
-func (c *CommandResponder) RespondCommand() {
-	switch c.Payload.Text {
-	case CommandEmpty:
-		c.RespondCommandEmpty()
-	case CommandManual:
-		c.RespondCommandManual()
-	case CommandHolidayCalendar:
-		c.RespondCommandHolidayCalendar()
-	case CommandForecast:
-		c.RespondCommandForecast()
-	default:
-		c.RespondCommandUndefined()
+// 커맨드와 액션, 바로가기를 라우터에 등록한다. 지원 기능 안내는 등록한 커맨드의 설명으로 만든다.
+// client 는 response_url 로 응답하거나 메시지를 보낼 때 사용한다.
+// 멘션과 DM 도 같은 라우터로 처리하므로 커맨드의 동작은 입력한 곳과 관계없이 같다.
+func newRouter(client *slack.Client) *bot.Router {
+	router := bot.NewRouter()
+	progress := func(context.Context, *bot.CommandRequest) *slack.InteractiveResponsePayload {
+		return &slack.InteractiveResponsePayload{Blocks: makeProgressMessage()}
 	}
-}
 
-func (c *CommandResponder) RespondCommandEmpty() {
-	Respond(c.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeManualMessage(),
-		ReplaceOriginal: true,
+	router.Default(func(ctx context.Context, req *bot.CommandRequest) {
+		respondManual(ctx, replyCommand(client, req), router.Commands())
 	})
-}
-
-func (c *CommandResponder) RespondCommandManual() {
-	Respond(c.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeManualMessage(),
-		ReplaceOriginal: true,
+	router.NotFound(func(ctx context.Context, req *bot.CommandRequest) {
+		replyCommand(client, req)(ctx, &slack.InteractiveResponsePayload{
+			Blocks:          makeGuideMessage(req.Suggestion),
+			ReplaceOriginal: true,
+		})
 	})
-}
 
-func (c *CommandResponder) RespondCommandHolidayCalendar() {
-	Respond(c.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeHolidayCalendarMessage(),
-		ReplaceOriginal: true,
-	})
-}
+	router.Command(CommandManual, func(ctx context.Context, req *bot.CommandRequest) {
+		respondManual(ctx, replyCommand(client, req), router.Commands())
+	}).Alias("도움말", "안내", "help")
+	// 외부 API 를 조회하는 커맨드는 응답까지 시간이 걸리므로 진행중 메시지를 먼저 보여준다.
+	// 결과는 response_url 로 진행중 메시지를 대체한다. 멘션과 DM 에는 결과만 보낸다.
+	router.Command(CommandHolidayCalendar, func(ctx context.Context, req *bot.CommandRequest) {
+		respondHolidayCalendar(ctx, replyCommand(client, req))
+	}).Alias("휴일", "holiday", "holidays").Describe("공휴일 안내").Button(ButtonActionHolidayCalendar).Ack(progress)
+	router.Command(CommandForecast, func(ctx context.Context, req *bot.CommandRequest) {
+		region := DefaultRegion
+		if name := req.Args.First(); name != "" {
+			region = suggestRegion(name)
+		}
+		respondForecast(ctx, replyCommand(client, req), region)
+	}).Alias("예보", "weather", "forecast").Describe("초단기 날씨 예보").Usage("[지역]").Button(ButtonActionForecast).Ack(progress)
 
-func (c *CommandResponder) RespondCommandForecast() {
-	Respond(c.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeForecastMessage(),
-		ReplaceOriginal: true,
+	router.Action(ButtonActionDone, func(ctx context.Context, req *bot.ActionRequest) {
+		// 완료 버튼 클릭.
+		respond(ctx, client, req.Payload.ResponseURL, &slack.InteractiveResponsePayload{
+			DeleteOriginal: true,
+		})
 	})
-}
-
-func (c *CommandResponder) RespondCommandUndefined() {
-	Respond(c.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeGuideMessage(),
-		ReplaceOriginal: true,
+	router.Action(ButtonActionManual, func(ctx context.Context, req *bot.ActionRequest) {
+		// 기능 안내 버튼 클릭.
+		respondManual(ctx, replyURL(client, req.Payload.ResponseURL), router.Commands())
 	})
-}
+	router.Action(ButtonActionHolidayCalendar, func(ctx context.Context, req *bot.ActionRequest) {
+		// 공휴일 안내 버튼 클릭.
+		reply := replyURL(client, req.Payload.ResponseURL)
+		respondProgress(ctx, reply)
+		respondHolidayCalendar(ctx, reply)
+	})
+	router.Action(ButtonActionForecast, func(ctx context.Context, req *bot.ActionRequest) {
+		// 날씨 버튼 클릭.
+		reply := replyURL(client, req.Payload.ResponseURL)
+		respondProgress(ctx, reply)
+		respondForecast(ctx, reply, DefaultRegion)
+	})
+	router.Action(SelectActionForecastRegion, func(ctx context.Context, req *bot.ActionRequest) {
+		// 날씨 지역 선택.
+		region := DefaultRegion
+		if values := req.Action.OptionValues(); len(values) > 0 {
+			if r, ok := findRegion(values[0]); ok {
+				region = r
+			}
+		}
+		reply := replyURL(client, req.Payload.ResponseURL)
+		respondProgress(ctx, reply)
+		respondForecast(ctx, reply, region)
+	})
+	registerShortcuts(router, client)
 
-type ActionResponder struct {
-	AppToken string
-	BotToken string
-	Payload  *slack.InteractiveEventPayload
+	return router
 }
 
-func (a *ActionResponder) RespondActions() {
-	for _, action := range a.Payload.Actions {
-		a.RespondAction(action)
+// 커맨드와 액션에 응답하는 함수.
+type replyFunc func(ctx context.Context, payload *slack.InteractiveResponsePayload)
+
+// response_url 로 응답한다. 응답하지 못하면 기록만 한다.
+func respond(ctx context.Context, client *slack.Client, url string, payload *slack.InteractiveResponsePayload) {
+	if err := client.Respond(ctx, url, payload); err != nil {
+		slog.Error("failed to respond", slog.Any("error", err))
 	}
 }
 
-func (a *ActionResponder) RespondAction(action slack.InteractiveAction) {
-	switch action.Type {
-	case blockkit.ElementTypeButton:
-		// 버튼 액션 처리.
-		a.RespondButtonAction(action)
-	case blockkit.ElementTypeSelect:
-		// 셀렉트 액션 처리.
-	default:
-		slog.Warn("undefined action type", slog.String("type", string(action.Type)))
+// response_url 로 응답하는 replyFunc.
+func replyURL(client *slack.Client, url string) replyFunc {
+	return func(ctx context.Context, payload *slack.InteractiveResponsePayload) {
+		respond(ctx, client, url, payload)
 	}
 }
 
-func (a *ActionResponder) RespondButtonAction(action slack.InteractiveAction) {
-	switch action.ActionID {
-	case ButtonActionDone:
-		// 완료 버튼 클릭.
-		a.RespondButtonActionDone()
-	case ButtonActionManual:
-		// 기능 안내 버튼 클릭.
-		a.RespondButtonActionManual()
-	case ButtonActionHolidayCalendar:
-		// 공휴일 안내 버튼 클릭.
-		a.RespondProgress()
-		a.RespondButtonActionHolidayCalendar()
-	case ButtonActionForecast:
-		// 날씨 버튼 클릭.
-		a.RespondProgress()
-		a.RespondButtonActionForecast()
+// 커맨드에 응답하는 replyFunc. 슬래시 커맨드는 response_url 로 응답하고,
+// 멘션과 DM 은 커맨드를 보낸 채널이나 스레드에 메시지를 보낸다.
+func replyCommand(client *slack.Client, req *bot.CommandRequest) replyFunc {
+	if req.Message == nil {
+		return replyURL(client, req.Payload.ResponseURL)
+	}
+	return func(ctx context.Context, payload *slack.InteractiveResponsePayload) {
+		_, err := client.PostMessage(ctx, &slack.PostMessageRequest{
+			Channel:         req.Message.Channel,
+			Text:            payload.Text,
+			Blocks:          payload.Blocks,
+			ThreadTimestamp: req.Message.ThreadTimestamp,
+		})
+		if err != nil {
+			slog.Error("failed to post message", slog.Any("error", err))
+		}
 	}
 }
 
-func (a *ActionResponder) RespondProgress() {
-	Respond(a.Payload.ResponseURL, &slack.InteractiveResponsePayload{
+func respondProgress(ctx context.Context, reply replyFunc) {
+	reply(ctx, &slack.InteractiveResponsePayload{
 		Blocks:          makeProgressMessage(),
 		ReplaceOriginal: true,
 	})
 }
 
-func (a *ActionResponder) RespondButtonActionDone() {
-	Respond(a.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		DeleteOriginal: true,
-	})
-}
-
-func (a *ActionResponder) RespondButtonActionManual() {
-	Respond(a.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeManualMessage(),
+func respondManual(ctx context.Context, reply replyFunc, commands []bot.CommandInfo) {
+	reply(ctx, &slack.InteractiveResponsePayload{
+		Blocks:          makeManualMessage(commands),
 		ReplaceOriginal: true,
 	})
 }
 
-func (a *ActionResponder) RespondButtonActionHolidayCalendar() {
-	Respond(a.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeHolidayCalendarMessage(),
+func respondHolidayCalendar(ctx context.Context, reply replyFunc) {
+	reply(ctx, &slack.InteractiveResponsePayload{
+		Blocks:          makeHolidayCalendarMessage(ctx),
 		ReplaceOriginal: true,
 	})
 }
 
-func (a *ActionResponder) RespondButtonActionForecast() {
-	Respond(a.Payload.ResponseURL, &slack.InteractiveResponsePayload{
-		Blocks:          makeForecastMessage(),
+func respondForecast(ctx context.Context, reply replyFunc, region Region) {
+	reply(ctx, &slack.InteractiveResponsePayload{
+		Blocks:          makeForecastMessage(ctx, region),
 		ReplaceOriginal: true,
 	})
 }
-
-func Respond(url string, payload *slack.InteractiveResponsePayload) {
-	bodyData, err := json.Marshal(payload)
-	if err != nil {
-		slog.Error("failed to respond", slog.Any("error", err))
-		return
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewReader(bodyData))
-	if err != nil {
-		slog.Error("failed to respond", slog.Any("error", err))
-		return
-	}
-
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		slog.Error("failed to respond", slog.Any("error", err))
-		return
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
-		return
-	}
-
-	if body, err := io.ReadAll(resp.Body); err == nil {
-		msg := string(body)
-		slog.Error("failed to respond", slog.Any("error", errors.New(msg)))
-		if strings.Contains(msg, "invalid_blocks") {
-			encoded := base64.StdEncoding.EncodeToString(bodyData)
-			slog.Info("request body", slog.String("base64", encoded))
-		}
-	} else {
-		slog.Error("failed to respond", slog.Any("error", errors.New(resp.Status)))
-	}
-}
@@ -1,13 +1,36 @@
 package app
 
+import (
+	"context"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// 사용자 목록 캐시를 갱신하는 주기.
+const directoryRefreshInterval = time.Hour
+
 type JarvisService struct {
 	AppToken string
 	BotToken string
+
+	// 요청 제한을 함께 관리할 수 있도록 모든 요청에서 하나의 클라이언트를 공유한다.
+	client *slack.Client
+	// 사용자 조회마다 슬랙을 호출하지 않도록 사용자 목록을 캐시한다.
+	directory *slack.Directory
 }
 
-func NewJarvisService(appToken string, botToken string) *JarvisService {
+func NewJarvisService(appToken string, botToken string, opts ...slack.Option) *JarvisService {
+	client := slack.NewClient(appToken, botToken, opts...)
 	return &JarvisService{
-		AppToken: appToken,
-		BotToken: botToken,
+		AppToken:  appToken,
+		BotToken:  botToken,
+		client:    client,
+		directory: slack.NewDirectory(client),
 	}
 }
+
+// ctx 가 끝날 때까지 사용자 목록 캐시를 주기적으로 갱신한다.
+func (j *JarvisService) RunDirectory(ctx context.Context) {
+	j.directory.Run(ctx, directoryRefreshInterval)
+}
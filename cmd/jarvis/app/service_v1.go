@@ -2,8 +2,6 @@ package app
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/slack"
 	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
@@ -13,24 +11,16 @@ import (
 var _ server.ServiceV1 = (*JarvisService)(nil)
 
 func (j *JarvisService) ListInvitedChannels(ctx context.Context) ([]string, error) {
-	client := &slack.Client{
-		AppToken: j.AppToken,
-		BotToken: j.BotToken,
-	}
 	req := &slack.ListChannelsRequest{
+		Limit: 200,
 		Types: slack.PrivateChannel,
 	}
-	resp, err := client.ListChannels(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	if !resp.OK {
-		return nil, errors.New(resp.Error)
-	}
 
-	channels := make([]string, 0, len(resp.Channels))
-	for _, channel := range resp.Channels {
+	channels := make([]string, 0)
+	for channel, err := range j.client.Channels(ctx, req) {
+		if err != nil {
+			return nil, err
+		}
 		if channel.IsMember {
 			channels = append(channels, channel.ID)
 		}
@@ -45,30 +35,21 @@ func (j *JarvisService) SendSlackMessage(
 	message string,
 	blocksData []byte,
 	markdown bool,
-) (float64, error) {
+) (string, error) {
 	req := &slack.PostMessageRequest{
 		Channel:  channel,
 		Text:     message,
 		Markdown: markdown,
 	}
-	if len(blocksData) > 0 {
-		blocks := make([]blockkit.SlackBlock, 0)
-		if err := json.Unmarshal(blocksData, &blocks); err != nil {
-			return 0, err
-		}
-		req.Blocks = blocks
+	blocks, err := parseBlocks(blocksData)
+	if err != nil {
+		return "", err
 	}
+	req.Blocks = blocks
 
-	client := &slack.Client{
-		AppToken: j.AppToken,
-		BotToken: j.BotToken,
-	}
-	resp, err := client.PostMessage(ctx, req)
+	resp, err := j.client.PostMessage(ctx, req)
 	if err != nil {
-		return 0, err
-	}
-	if !resp.OK {
-		return 0, errors.New(resp.Error)
+		return "", err
 	}
 
 	return resp.Timestamp, nil
@@ -78,24 +59,187 @@ func (j *JarvisService) GetUserProfile(
 	ctx context.Context,
 	userID string,
 ) (*server.UserProfileV1, error) {
-	client := &slack.Client{
-		AppToken: j.AppToken,
-		BotToken: j.BotToken,
+	user, err := j.directory.User(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userProfileV1(&user.Profile), nil
+}
+
+func (j *JarvisService) UpdateSlackMessage(
+	ctx context.Context,
+	channel string,
+	timestamp string,
+	message string,
+	blocksData []byte,
+) (string, error) {
+	blocks, err := parseBlocks(blocksData)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := j.client.UpdateMessage(ctx, &slack.UpdateMessageRequest{
+		Channel:   channel,
+		Timestamp: timestamp,
+		Text:      message,
+		Blocks:    blocks,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Timestamp, nil
+}
+
+func (j *JarvisService) DeleteSlackMessage(
+	ctx context.Context,
+	channel string,
+	timestamp string,
+) error {
+	_, err := j.client.DeleteMessage(ctx, &slack.DeleteMessageRequest{
+		Channel:   channel,
+		Timestamp: timestamp,
+	})
+	return err
+}
+
+func (j *JarvisService) SendEphemeralMessage(
+	ctx context.Context,
+	channel string,
+	user string,
+	message string,
+	blocksData []byte,
+	threadTimestamp string,
+) (string, error) {
+	blocks, err := parseBlocks(blocksData)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := j.client.PostEphemeral(ctx, &slack.PostEphemeralRequest{
+		Channel:         channel,
+		User:            user,
+		Text:            message,
+		Blocks:          blocks,
+		ThreadTimestamp: threadTimestamp,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.MessageTimestamp, nil
+}
+
+func (j *JarvisService) ScheduleSlackMessage(
+	ctx context.Context,
+	channel string,
+	message string,
+	blocksData []byte,
+	markdown bool,
+	postAt int64,
+) (string, int64, error) {
+	blocks, err := parseBlocks(blocksData)
+	if err != nil {
+		return "", 0, err
+	}
+
+	resp, err := j.client.ScheduleMessage(ctx, &slack.ScheduleMessageRequest{
+		Channel:  channel,
+		PostAt:   postAt,
+		Text:     message,
+		Blocks:   blocks,
+		Markdown: markdown,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	return resp.ScheduledMessageID, resp.PostAt, nil
+}
+
+func (j *JarvisService) ListUsers(
+	ctx context.Context,
+	name string,
+	includeBots bool,
+	includeDeleted bool,
+) ([]*server.UserV1, error) {
+	var (
+		users []slack.User
+		err   error
+	)
+	if name != "" {
+		users, err = j.directory.UsersByName(ctx, name)
+	} else {
+		users, err = j.directory.Users(ctx)
 	}
-	resp, err := client.GetUserProfile(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	profile := &server.UserProfileV1{
-		Title:       resp.Title,
-		DisplayName: resp.DisplayName,
-		RealName:    resp.RealName,
-		FirstName:   resp.FirstName,
-		LastName:    resp.LastName,
-		Email:       resp.Email,
-		Phone:       resp.Phone,
+	result := make([]*server.UserV1, 0, len(users))
+	for _, user := range users {
+		// 슬랙봇(USLACKBOT)은 is_bot 이 false 이므로 ID 로 구분한다.
+		if (user.IsBot || user.ID == "USLACKBOT") && !includeBots {
+			continue
+		}
+		if user.Deleted && !includeDeleted {
+			continue
+		}
+		result = append(result, userV1(&user))
 	}
 
-	return profile, nil
+	return result, nil
+}
+
+func (j *JarvisService) LookupUserByEmail(
+	ctx context.Context,
+	email string,
+) (*server.UserV1, error) {
+	user, err := j.directory.UserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return userV1(user), nil
+}
+
+func userV1(user *slack.User) *server.UserV1 {
+	return &server.UserV1{
+		Id:       user.ID,
+		Name:     user.Name,
+		RealName: user.RealName,
+		Tz:       user.TZ,
+		TzOffset: int32(user.TZOffset),
+		IsBot:    user.IsBot,
+		Deleted:  user.Deleted,
+		Profile:  userProfileV1(&user.Profile),
+	}
+}
+
+func userProfileV1(profile *slack.UserProfile) *server.UserProfileV1 {
+	return &server.UserProfileV1{
+		Title:       profile.Title,
+		DisplayName: profile.DisplayName,
+		RealName:    profile.RealName,
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		Email:       profile.Email,
+		Phone:       profile.Phone,
+	}
+}
+
+// gRPC 로 전달받은 블록을 디코딩하고, 슬랙으로 보내기 전에 제약 조건을 검사한다.
+func parseBlocks(data []byte) (blockkit.Blocks, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	blocks, err := blockkit.UnmarshalBlocks(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := blockkit.Blocks(blocks).Validate(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
 }
@@ -0,0 +1,323 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/cmd/jarvis/app"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func TestJarvisServiceListInvitedChannels(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	// 한 페이지로 조회할 수 없을 만큼 채널을 추가한다.
+	for i := range 250 {
+		server.AddChannel(slack.ConversationObject{
+			ID:        fmt.Sprintf("C%03d", i),
+			IsPrivate: true,
+			IsMember:  i%2 == 0,
+		})
+	}
+
+	service := app.NewJarvisService("xapp-test", "xoxb-test", server.ClientOptions()...)
+	channels, err := service.ListInvitedChannels(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(channels) != 125 {
+		t.Errorf("expected 125 channels, got %d", len(channels))
+	}
+}
+
+func TestJarvisServiceSendSlackMessage(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "C1", IsMember: true})
+
+	service := app.NewJarvisService("xapp-test", "xoxb-test", server.ClientOptions()...)
+	ctx := context.Background()
+
+	blocks := []byte(`[{"type": "header", "text": {"type": "plain_text", "text": "공지"}}]`)
+	ts, err := service.SendSlackMessage(ctx, "C1", "", blocks, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	messages := server.Messages("C1")
+	if len(messages) != 1 || messages[0].Timestamp != ts || messages[0].Text != "*공지*" {
+		t.Errorf("unexpected messages: %+v", messages)
+	}
+
+	if _, err := service.SendSlackMessage(ctx, "C404", "안녕", nil, false); !errors.Is(err, slack.ErrChannelNotFound) {
+		t.Errorf("expected channel_not_found, got %v", err)
+	}
+}
+
+func TestJarvisServiceUsers(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUser(slack.User{
+		ID:       "U1",
+		RealName: "홍길동",
+		TZ:       "Asia/Seoul",
+		TZOffset: 32400,
+		Profile:  slack.UserProfile{RealName: "홍길동", Title: "개발자", Email: "gildong@example.com"},
+	})
+	server.AddUser(slack.User{ID: "U2", Deleted: true, Profile: slack.UserProfile{RealName: "홍길동"}})
+	server.AddUser(slack.User{ID: "B1", IsBot: true, Profile: slack.UserProfile{RealName: "배포봇"}})
+
+	service := app.NewJarvisService("xapp-test", "xoxb-test", server.ClientOptions()...)
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc           string
+		name           string
+		includeBots    bool
+		includeDeleted bool
+		want           int
+	}{
+		{desc: "active users", want: 1},
+		{desc: "with bots", includeBots: true, want: 2},
+		{desc: "with deleted", includeDeleted: true, want: 2},
+		{desc: "by name", name: "홍길동", want: 1},
+		{desc: "by name excludes deleted", name: "홍길동", includeDeleted: true, want: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			users, err := service.ListUsers(ctx, tc.name, tc.includeBots, tc.includeDeleted)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(users) != tc.want {
+				t.Errorf("expected %d users, got %d", tc.want, len(users))
+			}
+		})
+	}
+
+	user, err := service.LookupUserByEmail(ctx, "gildong@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Id != "U1" || user.Tz != "Asia/Seoul" || user.TzOffset != 32400 || user.Profile.Title != "개발자" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	profile, err := service.GetUserProfile(ctx, "U1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.RealName != "홍길동" {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+	// 사용자 목록을 한 번 조회한 뒤로는 캐시에서 찾는다.
+	for _, req := range server.Requests() {
+		if req.Method != "users.list" {
+			t.Errorf("unexpected request: %s", req.Method)
+		}
+	}
+
+	if _, err := service.LookupUserByEmail(ctx, "nobody@example.com"); !errors.Is(err, slack.ErrUsersNotFound) {
+		t.Errorf("expected users_not_found, got %v", err)
+	}
+}
+
+func TestJarvisBotCommand(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+
+	jarvis := app.NewJarvisBot("xapp-test", "xoxb-test", server.ClientOptions()...)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	jarvis.HandleCommandEvent(ctx, &slack.SlashCommandEventPayload{
+		Text:        app.CommandManual,
+		ResponseURL: server.ResponseURL("manual"),
+	})
+
+	resp, err := server.WaitForResponse(ctx, "manual")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Payload.ReplaceOriginal || len(resp.Payload.Blocks) == 0 || resp.Payload.Text == "" {
+		t.Errorf("unexpected response: %+v", resp.Payload)
+	}
+}
+
+func TestJarvisBotMessageCommand(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "C1", IsMember: true})
+	server.AddChannel(slack.ConversationObject{ID: "D1", IsMember: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+	jarvis := app.NewJarvisBot("xapp-test", "xoxb-test", server.ClientOptions()...)
+
+	testCases := []struct {
+		desc    string
+		channel string
+		event   func(ts string) slack.InnerEvent
+		want    string
+	}{
+		{
+			desc:    "mention",
+			channel: "C1",
+			event: func(ts string) slack.InnerEvent {
+				return &slack.AppMentionEvent{User: "U1", Channel: "C1", Text: "<@U0SLACKTEST> 도움말", Timestamp: ts}
+			},
+			want: "지원 기능",
+		},
+		{
+			desc:    "mention with typo",
+			channel: "C1",
+			event: func(ts string) slack.InnerEvent {
+				return &slack.AppMentionEvent{User: "U1", Channel: "C1", Text: "<@U0SLACKTEST> 날시 서울", Timestamp: ts}
+			},
+			want: "/자비스 날씨",
+		},
+		{
+			desc:    "direct message in thread",
+			channel: "D1",
+			event: func(ts string) slack.InnerEvent {
+				return &slack.MessageEvent{User: "U1", Channel: "D1", ChannelType: "im", Text: "help", Timestamp: ts, ThreadTimestamp: ts}
+			},
+			want: "지원 기능",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// 사용자가 보낸 메시지의 스레드로 응답한다.
+			parent, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: tc.channel, Text: "자비스"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			jarvis.HandleEventsAPIEvent(ctx, &slack.EventsAPIPayload{Event: tc.event(parent.Timestamp)})
+
+			var reply *slack.MessageObject
+			for _, message := range server.Messages(tc.channel) {
+				if message.ThreadTimestamp == parent.Timestamp && message.Timestamp != parent.Timestamp {
+					reply = &message
+				}
+			}
+			if reply == nil {
+				t.Fatal("reply was not sent")
+			}
+			if !strings.Contains(reply.Text, tc.want) {
+				t.Errorf("expected reply to contain %q, got %q", tc.want, reply.Text)
+			}
+		})
+	}
+}
+
+func TestJarvisBotShortcuts(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "C1", IsMember: true})
+	// 봇과의 DM 은 사용자 ID 로 메시지를 보낸다.
+	server.AddChannel(slack.ConversationObject{ID: "U1", IsMember: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+	parent, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "배포 일정 공유드립니다\n상세 내용은 문서를 확인해주세요"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reply, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "확인했습니다", ThreadTimestamp: parent.Timestamp})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	jarvis := app.NewJarvisBot("xapp-test", "xoxb-test", server.ClientOptions()...)
+	messageShortcut := func(callbackID, responseID string) *slack.InteractiveEventPayload {
+		return &slack.InteractiveEventPayload{
+			Type:        slack.InteractionTypeMessageAction,
+			CallbackID:  callbackID,
+			User:        slack.InteractiveUser{ID: "U1"},
+			Channel:     slack.InteractiveChannel{ID: "C1"},
+			ResponseURL: server.ResponseURL(responseID),
+			Message: &slack.MessageObject{
+				User:            slacktest.BotUserID,
+				Text:            "확인했습니다",
+				Timestamp:       reply.Timestamp,
+				ThreadTimestamp: parent.Timestamp,
+			},
+		}
+	}
+
+	t.Run("menu", func(t *testing.T) {
+		jarvis.HandleShortcut(ctx, &slack.InteractiveEventPayload{
+			Type:       slack.InteractionTypeShortcut,
+			CallbackID: app.ShortcutMenu,
+			User:       slack.InteractiveUser{ID: "U1"},
+		})
+		messages, err := server.WaitForMessages(ctx, "U1", 1)
+		if err != nil {
+			t.Fatalf("menu was not sent: %v", err)
+		}
+		if !strings.Contains(messages[0].Text, "지원 기능") {
+			t.Errorf("unexpected menu: %q", messages[0].Text)
+		}
+	})
+
+	t.Run("remind message", func(t *testing.T) {
+		jarvis.HandleMessageShortcut(ctx, messageShortcut(app.ShortcutRemindMessage, "remind"))
+		resp, err := server.WaitForResponse(ctx, "remind")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Payload.ResponseType != slack.Ephemeral || !strings.Contains(resp.Payload.Text, "다시 알려드릴게요") {
+			t.Errorf("unexpected response: %+v", resp.Payload)
+		}
+
+		scheduled := server.ScheduledMessages()
+		if len(scheduled) != 1 || scheduled[0].Channel != "U1" {
+			t.Fatalf("unexpected scheduled messages: %+v", scheduled)
+		}
+		if after := time.Until(time.Unix(scheduled[0].PostAt, 0)); after < 59*time.Minute || after > time.Hour {
+			t.Errorf("unexpected post at: %v", after)
+		}
+		if !strings.Contains(scheduled[0].Text, "확인했습니다") || !strings.Contains(scheduled[0].Text, "원본 보기") {
+			t.Errorf("unexpected remind message: %q", scheduled[0].Text)
+		}
+	})
+
+	t.Run("message shortcut without message", func(t *testing.T) {
+		payload := messageShortcut(app.ShortcutRemindMessage, "no message")
+		payload.Message = nil
+		jarvis.HandleMessageShortcut(ctx, payload)
+		resp, err := server.WaitForResponse(ctx, "no message")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Payload.ResponseType != slack.Ephemeral || !strings.Contains(resp.Payload.Text, "메시지를 찾을 수 없습니다") {
+			t.Errorf("unexpected response: %+v", resp.Payload)
+		}
+		if scheduled := server.ScheduledMessages(); len(scheduled) != 1 {
+			t.Errorf("expected no more scheduled messages, got %d", len(scheduled))
+		}
+	})
+
+	t.Run("export thread summary", func(t *testing.T) {
+		jarvis.HandleMessageShortcut(ctx, messageShortcut(app.ShortcutExportThreadSummary, "summary"))
+		if _, err := server.WaitForResponse(ctx, "summary"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		messages, err := server.WaitForMessages(ctx, "U1", 2)
+		if err != nil {
+			t.Fatalf("summary was not sent: %v", err)
+		}
+		summary := messages[1].Text
+		for _, want := range []string{"스레드 요약", "2개", "배포 일정 공유드립니다 …", "확인했습니다", "스레드 열기"} {
+			if !strings.Contains(summary, want) {
+				t.Errorf("expected summary to contain %q, got %q", want, summary)
+			}
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+)
+
+// 메시지 리마인드를 보낼 때까지의 시간.
+const remindAfter = time.Hour
+
+// 스레드 요약에 포함할 최대 메시지 수.
+const threadSummaryLimit = 500
+
+// 바로가기를 라우터에 등록한다. 바로가기는 슬랙 앱 설정에 같은 callback_id 로 등록되어 있어야 한다.
+func registerShortcuts(router *bot.Router, client *slack.Client) {
+	router.Shortcut(ShortcutMenu, func(ctx context.Context, payload *slack.InteractiveEventPayload) {
+		// 전역 바로가기에는 채널과 response_url 이 없으므로 봇과의 DM 으로 지원 기능 안내를 보낸다.
+		postDirectMessage(ctx, client, payload.User.ID, makeManualMessage(router.Commands()))
+	})
+	router.MessageShortcut(ShortcutRemindMessage, messageShortcut(client, remindMessage))
+	router.MessageShortcut(ShortcutExportThreadSummary, messageShortcut(client, exportThreadSummary))
+}
+
+// 바로가기를 실행한 메시지가 있을 때만 handle 을 실행하는 메시지 바로가기 핸들러.
+// 잘못된 요청이나 다시 처리하는 기록에는 메시지가 없을 수 있으므로 사용자에게 오류를 알린다.
+func messageShortcut(
+	client *slack.Client,
+	handle func(ctx context.Context, client *slack.Client, payload *slack.InteractiveEventPayload),
+) bot.ShortcutFunc {
+	return func(ctx context.Context, payload *slack.InteractiveEventPayload) {
+		if payload.Message == nil {
+			slog.Warn("message shortcut without message", slog.String("callback_id", payload.CallbackID))
+			respondEphemeral(ctx, client, payload.ResponseURL, makeNoticeMessage("⚠️ 바로가기를 실행한 메시지를 찾을 수 없습니다."))
+			return
+		}
+		handle(ctx, client, payload)
+	}
+}
+
+// 바로가기를 실행한 메시지를 remindAfter 뒤에 사용자에게 DM 으로 다시 보낸다.
+func remindMessage(ctx context.Context, client *slack.Client, payload *slack.InteractiveEventPayload) {
+	postAt := kst.Now().Add(remindAfter)
+	permalink := getPermalink(ctx, client, payload.Channel.ID, payload.Message.Timestamp)
+	_, err := client.ScheduleMessage(ctx, &slack.ScheduleMessageRequest{
+		Channel: payload.User.ID,
+		PostAt:  postAt.Unix(),
+		Blocks:  makeRemindMessage(payload.Message, permalink),
+	})
+	if err != nil {
+		slog.Error("failed to schedule remind message", slog.Any("error", err))
+		respondEphemeral(ctx, client, payload.ResponseURL, makeErrorMessage(err))
+		return
+	}
+	respondEphemeral(ctx, client, payload.ResponseURL, makeNoticeMessage(
+		fmt.Sprintf("⏰ %s 에 DM 으로 다시 알려드릴게요.", postAt.Format("15:04")),
+	))
+}
+
+// 바로가기를 실행한 메시지가 속한 스레드를 요약해서 사용자에게 DM 으로 보낸다.
+func exportThreadSummary(ctx context.Context, client *slack.Client, payload *slack.InteractiveEventPayload) {
+	channel, thread := payload.Channel.ID, payload.ThreadTimestamp()
+	messages, err := slack.Collect(client.Replies(ctx, &slack.ListRepliesRequest{
+		Channel:   channel,
+		Timestamp: thread,
+		Limit:     200,
+	}), threadSummaryLimit)
+	if err != nil {
+		// 봇이 초대되지 않은 채널의 스레드는 읽을 수 없다.
+		slog.Error("failed to list replies", slog.Any("error", err))
+		respondEphemeral(ctx, client, payload.ResponseURL, makeErrorMessage(err))
+		return
+	}
+
+	permalink := getPermalink(ctx, client, channel, thread)
+	if !postDirectMessage(ctx, client, payload.User.ID, makeThreadSummaryMessage(channel, messages, permalink)) {
+		respondEphemeral(ctx, client, payload.ResponseURL, makeNoticeMessage("⚠️ 스레드 요약을 보내지 못했습니다."))
+		return
+	}
+	respondEphemeral(ctx, client, payload.ResponseURL, makeNoticeMessage("📤 스레드 요약을 DM 으로 보냈습니다."))
+}
+
+// 메시지의 링크를 가져온다. 가져오지 못하면 빈 문자열을 반환한다.
+func getPermalink(ctx context.Context, client *slack.Client, channel string, ts string) string {
+	resp, err := client.GetPermalink(ctx, &slack.GetPermalinkRequest{
+		Channel:          channel,
+		MessageTimestamp: ts,
+	})
+	if err != nil {
+		slog.Warn("failed to get permalink", slog.Any("error", err))
+		return ""
+	}
+	return resp.Permalink
+}
+
+// 봇과의 DM 으로 메시지를 보낸다. 보내지 못하면 false 를 반환한다.
+func postDirectMessage(ctx context.Context, client *slack.Client, userID string, blocks []blockkit.SlackBlock) bool {
+	_, err := client.PostMessage(ctx, &slack.PostMessageRequest{
+		Channel: userID,
+		Blocks:  blocks,
+	})
+	if err != nil {
+		slog.Error("failed to post direct message", slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+func respondEphemeral(ctx context.Context, client *slack.Client, url string, blocks []blockkit.SlackBlock) {
+	respond(ctx, client, url, &slack.InteractiveResponsePayload{
+		ResponseType: slack.Ephemeral,
+		Blocks:       blocks,
+	})
+}
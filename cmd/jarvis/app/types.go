@@ -1,5 +1,12 @@
 package app
 
+import (
+	"slices"
+	"strings"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/hangul"
+)
+
 type Command = string
 
 const (
@@ -17,3 +24,77 @@ const (
 	ButtonActionHolidayCalendar ButtonAction = "holiday"
 	ButtonActionForecast        ButtonAction = "forecast"
 )
+
+type SelectAction = string
+
+const (
+	SelectActionForecastRegion SelectAction = "forecast_region"
+)
+
+// 슬랙 앱 설정에 등록한 바로가기의 callback_id.
+type Shortcut = string
+
+const (
+	// 자비스 메뉴. 전역 바로가기.
+	ShortcutMenu Shortcut = "jarvis_menu"
+	// 이 메시지 리마인드. 메시지 바로가기.
+	ShortcutRemindMessage Shortcut = "remind_message"
+	// 스레드 요약 내보내기. 메시지 바로가기.
+	ShortcutExportThreadSummary Shortcut = "export_thread_summary"
+)
+
+// 초단기 예보를 조회할 지역. 좌표는 기상청 격자 좌표를 사용한다.
+type Region struct {
+	Name string
+	// 커맨드 인자로 입력할 수 있는 다른 이름.
+	Aliases []string
+	NX      int32
+	NY      int32
+}
+
+var (
+	// 지역을 선택하지 않았을 때 조회하는 좌표.
+	DefaultRegion = Region{NX: 60, NY: 123}
+	// 선택할 수 있는 지역 목록.
+	Regions = []Region{
+		{Name: "서울", Aliases: []string{"seoul"}, NX: 60, NY: 127},
+		{Name: "인천", Aliases: []string{"incheon"}, NX: 55, NY: 124},
+		{Name: "수원", Aliases: []string{"suwon"}, NX: 60, NY: 121},
+		{Name: "대전", Aliases: []string{"daejeon"}, NX: 67, NY: 100},
+		{Name: "세종", Aliases: []string{"sejong"}, NX: 66, NY: 103},
+		{Name: "광주", Aliases: []string{"gwangju"}, NX: 58, NY: 74},
+		{Name: "대구", Aliases: []string{"daegu"}, NX: 89, NY: 90},
+		{Name: "울산", Aliases: []string{"ulsan"}, NX: 102, NY: 84},
+		{Name: "부산", Aliases: []string{"busan"}, NX: 98, NY: 76},
+		{Name: "제주", Aliases: []string{"jeju"}, NX: 53, NY: 38},
+	}
+)
+
+// 이름이나 별칭에 해당하는 지역을 찾는다.
+func findRegion(name string) (Region, bool) {
+	for _, region := range Regions {
+		if region.Name == name || slices.ContainsFunc(region.Aliases, func(alias string) bool {
+			return strings.EqualFold(alias, name)
+		}) {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// 입력한 이름에 해당하는 지역을 찾는다. 일치하는 지역이 없으면 자모 단위로 가장 비슷한 지역을 찾고,
+// 비슷한 지역도 없으면 DefaultRegion 을 반환한다. (e.g. "서을" → 서울)
+func suggestRegion(name string) Region {
+	if region, ok := findRegion(name); ok {
+		return region
+	}
+
+	names := make([]string, len(Regions))
+	for i, region := range Regions {
+		names[i] = region.Name
+	}
+	if i, ok := hangul.Closest(name, names); ok {
+		return Regions[i]
+	}
+	return DefaultRegion
+}
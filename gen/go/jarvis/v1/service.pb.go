@@ -170,8 +170,9 @@ func (x *SendSlackMessageRequest) GetMarkdown() bool {
 }
 
 type SendSlackMessageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     float64                `protobuf:"fixed64,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 게시한 메시지의 ts. 슬랙이 응답한 문자열을 그대로 전달한다. (e.g. "1503435956.000240")
+	Timestamp     string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -206,11 +207,11 @@ func (*SendSlackMessageResponse) Descriptor() ([]byte, []int) {
 	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SendSlackMessageResponse) GetTimestamp() float64 {
+func (x *SendSlackMessageResponse) GetTimestamp() string {
 	if x != nil {
 		return x.Timestamp
 	}
-	return 0
+	return ""
 }
 
 type UserProfile struct {
@@ -401,6 +402,754 @@ func (x *GetUserProfileResponse) GetProfile() *UserProfile {
 	return nil
 }
 
+type UpdateSlackMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Blocks    []byte                 `protobuf:"bytes,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// 수정할 메시지의 ts. 숫자로 변환하면 끝자리의 0 이 사라지므로 문자열 그대로 전달해야 한다.
+	Timestamp     string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSlackMessageRequest) Reset() {
+	*x = UpdateSlackMessageRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlackMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlackMessageRequest) ProtoMessage() {}
+
+func (x *UpdateSlackMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSlackMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSlackMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateSlackMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UpdateSlackMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateSlackMessageRequest) GetBlocks() []byte {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *UpdateSlackMessageRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type UpdateSlackMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSlackMessageResponse) Reset() {
+	*x = UpdateSlackMessageResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSlackMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSlackMessageResponse) ProtoMessage() {}
+
+func (x *UpdateSlackMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSlackMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSlackMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSlackMessageResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type DeleteSlackMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// 삭제할 메시지의 ts.
+	Timestamp     string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSlackMessageRequest) Reset() {
+	*x = DeleteSlackMessageRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSlackMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSlackMessageRequest) ProtoMessage() {}
+
+func (x *DeleteSlackMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSlackMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSlackMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSlackMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DeleteSlackMessageRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type DeleteSlackMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSlackMessageResponse) Reset() {
+	*x = DeleteSlackMessageResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSlackMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSlackMessageResponse) ProtoMessage() {}
+
+func (x *DeleteSlackMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSlackMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSlackMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+type SendEphemeralMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Blocks    []byte                 `protobuf:"bytes,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// 메시지를 보낼 스레드의 ts.
+	ThreadTimestamp string `protobuf:"bytes,6,opt,name=thread_timestamp,json=threadTimestamp,proto3" json:"thread_timestamp,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SendEphemeralMessageRequest) Reset() {
+	*x = SendEphemeralMessageRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralMessageRequest) ProtoMessage() {}
+
+func (x *SendEphemeralMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendEphemeralMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendEphemeralMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetBlocks() []byte {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *SendEphemeralMessageRequest) GetThreadTimestamp() string {
+	if x != nil {
+		return x.ThreadTimestamp
+	}
+	return ""
+}
+
+type SendEphemeralMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEphemeralMessageResponse) Reset() {
+	*x = SendEphemeralMessageResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralMessageResponse) ProtoMessage() {}
+
+func (x *SendEphemeralMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendEphemeralMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendEphemeralMessageResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type ScheduleSlackMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Blocks    []byte                 `protobuf:"bytes,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Markdown  bool                   `protobuf:"varint,4,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	// 메시지를 게시할 시각 (unix-timestamp seconds).
+	PostAt        int64 `protobuf:"varint,5,opt,name=post_at,json=postAt,proto3" json:"post_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSlackMessageRequest) Reset() {
+	*x = ScheduleSlackMessageRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSlackMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSlackMessageRequest) ProtoMessage() {}
+
+func (x *ScheduleSlackMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSlackMessageRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleSlackMessageRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScheduleSlackMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ScheduleSlackMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScheduleSlackMessageRequest) GetBlocks() []byte {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ScheduleSlackMessageRequest) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *ScheduleSlackMessageRequest) GetPostAt() int64 {
+	if x != nil {
+		return x.PostAt
+	}
+	return 0
+}
+
+type ScheduleSlackMessageResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessageId string                 `protobuf:"bytes,1,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
+	PostAt             int64                  `protobuf:"varint,2,opt,name=post_at,json=postAt,proto3" json:"post_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScheduleSlackMessageResponse) Reset() {
+	*x = ScheduleSlackMessageResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSlackMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSlackMessageResponse) ProtoMessage() {}
+
+func (x *ScheduleSlackMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSlackMessageResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleSlackMessageResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScheduleSlackMessageResponse) GetScheduledMessageId() string {
+	if x != nil {
+		return x.ScheduledMessageId
+	}
+	return ""
+}
+
+func (x *ScheduleSlackMessageResponse) GetPostAt() int64 {
+	if x != nil {
+		return x.PostAt
+	}
+	return 0
+}
+
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RealName string                 `protobuf:"bytes,3,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	// 사용자가 설정한 시간대 (e.g. "Asia/Seoul").
+	Tz string `protobuf:"bytes,4,opt,name=tz,proto3" json:"tz,omitempty"`
+	// UTC 와의 시차 (seconds).
+	TzOffset int32 `protobuf:"varint,5,opt,name=tz_offset,json=tzOffset,proto3" json:"tz_offset,omitempty"`
+	IsBot    bool  `protobuf:"varint,6,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
+	// 비활성화된 사용자인지 여부.
+	Deleted       bool         `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Profile       *UserProfile `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *User) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
+func (x *User) GetTzOffset() int32 {
+	if x != nil {
+		return x.TzOffset
+	}
+	return 0
+}
+
+func (x *User) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+func (x *User) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *User) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 비어있지 않으면 이름이 일치하는 사용자만 반환한다.
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IncludeBots    bool   `protobuf:"varint,2,opt,name=include_bots,json=includeBots,proto3" json:"include_bots,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUsersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIncludeBots() bool {
+	if x != nil {
+		return x.IncludeBots
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type LookupUserByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupUserByEmailRequest) Reset() {
+	*x = LookupUserByEmailRequest{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupUserByEmailRequest) ProtoMessage() {}
+
+func (x *LookupUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*LookupUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LookupUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type LookupUserByEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupUserByEmailResponse) Reset() {
+	*x = LookupUserByEmailResponse{}
+	mi := &file_jarvis_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupUserByEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupUserByEmailResponse) ProtoMessage() {}
+
+func (x *LookupUserByEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jarvis_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupUserByEmailResponse.ProtoReflect.Descriptor instead.
+func (*LookupUserByEmailResponse) Descriptor() ([]byte, []int) {
+	return file_jarvis_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LookupUserByEmailResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_jarvis_v1_service_proto protoreflect.FileDescriptor
 
 const file_jarvis_v1_service_proto_rawDesc = "" +
@@ -415,9 +1164,9 @@ const file_jarvis_v1_service_proto_rawDesc = "" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
 	"\x06blocks\x18\x03 \x01(\fR\x06blocks\x12\x1a\n" +
-	"\bmarkdown\x18\x04 \x01(\bR\bmarkdown\"8\n" +
+	"\bmarkdown\x18\x04 \x01(\bR\bmarkdown\">\n" +
 	"\x18SendSlackMessageResponse\x12\x1c\n" +
-	"\ttimestamp\x18\x01 \x01(\x01R\ttimestamp\"\xcb\x01\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestampJ\x04\b\x01\x10\x02\"\xcb\x01\n" +
 	"\vUserProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1b\n" +
@@ -431,11 +1180,68 @@ const file_jarvis_v1_service_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"c\n" +
 	"\x16GetUserProfileResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
-	"\aprofile\x18\x02 \x01(\v2\x16.jarvis.v1.UserProfileR\aprofile2\xaf\x02\n" +
+	"\aprofile\x18\x02 \x01(\v2\x16.jarvis.v1.UserProfileR\aprofile\"\x90\x01\n" +
+	"\x19UpdateSlackMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06blocks\x18\x04 \x01(\fR\x06blocks\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestampJ\x04\b\x02\x10\x03\"@\n" +
+	"\x1aUpdateSlackMessageResponse\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestampJ\x04\b\x01\x10\x02\"^\n" +
+	"\x19DeleteSlackMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\tR\ttimestampJ\x04\b\x02\x10\x03\"\x1c\n" +
+	"\x1aDeleteSlackMessageResponse\"\xb8\x01\n" +
+	"\x1bSendEphemeralMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06blocks\x18\x04 \x01(\fR\x06blocks\x12)\n" +
+	"\x10thread_timestamp\x18\x06 \x01(\tR\x0fthreadTimestampJ\x04\b\x05\x10\x06\"B\n" +
+	"\x1cSendEphemeralMessageResponse\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestampJ\x04\b\x01\x10\x02\"\xa3\x01\n" +
+	"\x1bScheduleSlackMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06blocks\x18\x03 \x01(\fR\x06blocks\x12\x1a\n" +
+	"\bmarkdown\x18\x04 \x01(\bR\bmarkdown\x12\x17\n" +
+	"\apost_at\x18\x05 \x01(\x03R\x06postAt\"i\n" +
+	"\x1cScheduleSlackMessageResponse\x120\n" +
+	"\x14scheduled_message_id\x18\x01 \x01(\tR\x12scheduledMessageId\x12\x17\n" +
+	"\apost_at\x18\x02 \x01(\x03R\x06postAt\"\xd7\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\treal_name\x18\x03 \x01(\tR\brealName\x12\x0e\n" +
+	"\x02tz\x18\x04 \x01(\tR\x02tz\x12\x1b\n" +
+	"\ttz_offset\x18\x05 \x01(\x05R\btzOffset\x12\x15\n" +
+	"\x06is_bot\x18\x06 \x01(\bR\x05isBot\x12\x18\n" +
+	"\adeleted\x18\a \x01(\bR\adeleted\x120\n" +
+	"\aprofile\x18\b \x01(\v2\x16.jarvis.v1.UserProfileR\aprofile\"r\n" +
+	"\x10ListUsersRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\finclude_bots\x18\x02 \x01(\bR\vincludeBots\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\":\n" +
+	"\x11ListUsersResponse\x12%\n" +
+	"\x05users\x18\x01 \x03(\v2\x0f.jarvis.v1.UserR\x05users\"0\n" +
+	"\x18LookupUserByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"@\n" +
+	"\x19LookupUserByEmailResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.jarvis.v1.UserR\x04user2\xfb\x06\n" +
 	"\rJarvisService\x12f\n" +
 	"\x13ListInvitedChannels\x12%.jarvis.v1.ListInvitedChannelsRequest\x1a&.jarvis.v1.ListInvitedChannelsResponse\"\x00\x12]\n" +
 	"\x10SendSlackMessage\x12\".jarvis.v1.SendSlackMessageRequest\x1a#.jarvis.v1.SendSlackMessageResponse\"\x00\x12W\n" +
-	"\x0eGetUserProfile\x12 .jarvis.v1.GetUserProfileRequest\x1a!.jarvis.v1.GetUserProfileResponse\"\x00B3Z1github.com/joyfuldevs/project-jarvis/proto/jarvisb\x06proto3"
+	"\x0eGetUserProfile\x12 .jarvis.v1.GetUserProfileRequest\x1a!.jarvis.v1.GetUserProfileResponse\"\x00\x12c\n" +
+	"\x12UpdateSlackMessage\x12$.jarvis.v1.UpdateSlackMessageRequest\x1a%.jarvis.v1.UpdateSlackMessageResponse\"\x00\x12c\n" +
+	"\x12DeleteSlackMessage\x12$.jarvis.v1.DeleteSlackMessageRequest\x1a%.jarvis.v1.DeleteSlackMessageResponse\"\x00\x12i\n" +
+	"\x14SendEphemeralMessage\x12&.jarvis.v1.SendEphemeralMessageRequest\x1a'.jarvis.v1.SendEphemeralMessageResponse\"\x00\x12i\n" +
+	"\x14ScheduleSlackMessage\x12&.jarvis.v1.ScheduleSlackMessageRequest\x1a'.jarvis.v1.ScheduleSlackMessageResponse\"\x00\x12H\n" +
+	"\tListUsers\x12\x1b.jarvis.v1.ListUsersRequest\x1a\x1c.jarvis.v1.ListUsersResponse\"\x00\x12`\n" +
+	"\x11LookupUserByEmail\x12#.jarvis.v1.LookupUserByEmailRequest\x1a$.jarvis.v1.LookupUserByEmailResponse\"\x00B3Z1github.com/joyfuldevs/project-jarvis/proto/jarvisb\x06proto3"
 
 var (
 	file_jarvis_v1_service_proto_rawDescOnce sync.Once
@@ -449,29 +1255,57 @@ func file_jarvis_v1_service_proto_rawDescGZIP() []byte {
 	return file_jarvis_v1_service_proto_rawDescData
 }
 
-var file_jarvis_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_jarvis_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_jarvis_v1_service_proto_goTypes = []any{
-	(*ListInvitedChannelsRequest)(nil),  // 0: jarvis.v1.ListInvitedChannelsRequest
-	(*ListInvitedChannelsResponse)(nil), // 1: jarvis.v1.ListInvitedChannelsResponse
-	(*SendSlackMessageRequest)(nil),     // 2: jarvis.v1.SendSlackMessageRequest
-	(*SendSlackMessageResponse)(nil),    // 3: jarvis.v1.SendSlackMessageResponse
-	(*UserProfile)(nil),                 // 4: jarvis.v1.UserProfile
-	(*GetUserProfileRequest)(nil),       // 5: jarvis.v1.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),      // 6: jarvis.v1.GetUserProfileResponse
+	(*ListInvitedChannelsRequest)(nil),   // 0: jarvis.v1.ListInvitedChannelsRequest
+	(*ListInvitedChannelsResponse)(nil),  // 1: jarvis.v1.ListInvitedChannelsResponse
+	(*SendSlackMessageRequest)(nil),      // 2: jarvis.v1.SendSlackMessageRequest
+	(*SendSlackMessageResponse)(nil),     // 3: jarvis.v1.SendSlackMessageResponse
+	(*UserProfile)(nil),                  // 4: jarvis.v1.UserProfile
+	(*GetUserProfileRequest)(nil),        // 5: jarvis.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),       // 6: jarvis.v1.GetUserProfileResponse
+	(*UpdateSlackMessageRequest)(nil),    // 7: jarvis.v1.UpdateSlackMessageRequest
+	(*UpdateSlackMessageResponse)(nil),   // 8: jarvis.v1.UpdateSlackMessageResponse
+	(*DeleteSlackMessageRequest)(nil),    // 9: jarvis.v1.DeleteSlackMessageRequest
+	(*DeleteSlackMessageResponse)(nil),   // 10: jarvis.v1.DeleteSlackMessageResponse
+	(*SendEphemeralMessageRequest)(nil),  // 11: jarvis.v1.SendEphemeralMessageRequest
+	(*SendEphemeralMessageResponse)(nil), // 12: jarvis.v1.SendEphemeralMessageResponse
+	(*ScheduleSlackMessageRequest)(nil),  // 13: jarvis.v1.ScheduleSlackMessageRequest
+	(*ScheduleSlackMessageResponse)(nil), // 14: jarvis.v1.ScheduleSlackMessageResponse
+	(*User)(nil),                         // 15: jarvis.v1.User
+	(*ListUsersRequest)(nil),             // 16: jarvis.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 17: jarvis.v1.ListUsersResponse
+	(*LookupUserByEmailRequest)(nil),     // 18: jarvis.v1.LookupUserByEmailRequest
+	(*LookupUserByEmailResponse)(nil),    // 19: jarvis.v1.LookupUserByEmailResponse
 }
 var file_jarvis_v1_service_proto_depIdxs = []int32{
-	4, // 0: jarvis.v1.GetUserProfileResponse.profile:type_name -> jarvis.v1.UserProfile
-	0, // 1: jarvis.v1.JarvisService.ListInvitedChannels:input_type -> jarvis.v1.ListInvitedChannelsRequest
-	2, // 2: jarvis.v1.JarvisService.SendSlackMessage:input_type -> jarvis.v1.SendSlackMessageRequest
-	5, // 3: jarvis.v1.JarvisService.GetUserProfile:input_type -> jarvis.v1.GetUserProfileRequest
-	1, // 4: jarvis.v1.JarvisService.ListInvitedChannels:output_type -> jarvis.v1.ListInvitedChannelsResponse
-	3, // 5: jarvis.v1.JarvisService.SendSlackMessage:output_type -> jarvis.v1.SendSlackMessageResponse
-	6, // 6: jarvis.v1.JarvisService.GetUserProfile:output_type -> jarvis.v1.GetUserProfileResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: jarvis.v1.GetUserProfileResponse.profile:type_name -> jarvis.v1.UserProfile
+	4,  // 1: jarvis.v1.User.profile:type_name -> jarvis.v1.UserProfile
+	15, // 2: jarvis.v1.ListUsersResponse.users:type_name -> jarvis.v1.User
+	15, // 3: jarvis.v1.LookupUserByEmailResponse.user:type_name -> jarvis.v1.User
+	0,  // 4: jarvis.v1.JarvisService.ListInvitedChannels:input_type -> jarvis.v1.ListInvitedChannelsRequest
+	2,  // 5: jarvis.v1.JarvisService.SendSlackMessage:input_type -> jarvis.v1.SendSlackMessageRequest
+	5,  // 6: jarvis.v1.JarvisService.GetUserProfile:input_type -> jarvis.v1.GetUserProfileRequest
+	7,  // 7: jarvis.v1.JarvisService.UpdateSlackMessage:input_type -> jarvis.v1.UpdateSlackMessageRequest
+	9,  // 8: jarvis.v1.JarvisService.DeleteSlackMessage:input_type -> jarvis.v1.DeleteSlackMessageRequest
+	11, // 9: jarvis.v1.JarvisService.SendEphemeralMessage:input_type -> jarvis.v1.SendEphemeralMessageRequest
+	13, // 10: jarvis.v1.JarvisService.ScheduleSlackMessage:input_type -> jarvis.v1.ScheduleSlackMessageRequest
+	16, // 11: jarvis.v1.JarvisService.ListUsers:input_type -> jarvis.v1.ListUsersRequest
+	18, // 12: jarvis.v1.JarvisService.LookupUserByEmail:input_type -> jarvis.v1.LookupUserByEmailRequest
+	1,  // 13: jarvis.v1.JarvisService.ListInvitedChannels:output_type -> jarvis.v1.ListInvitedChannelsResponse
+	3,  // 14: jarvis.v1.JarvisService.SendSlackMessage:output_type -> jarvis.v1.SendSlackMessageResponse
+	6,  // 15: jarvis.v1.JarvisService.GetUserProfile:output_type -> jarvis.v1.GetUserProfileResponse
+	8,  // 16: jarvis.v1.JarvisService.UpdateSlackMessage:output_type -> jarvis.v1.UpdateSlackMessageResponse
+	10, // 17: jarvis.v1.JarvisService.DeleteSlackMessage:output_type -> jarvis.v1.DeleteSlackMessageResponse
+	12, // 18: jarvis.v1.JarvisService.SendEphemeralMessage:output_type -> jarvis.v1.SendEphemeralMessageResponse
+	14, // 19: jarvis.v1.JarvisService.ScheduleSlackMessage:output_type -> jarvis.v1.ScheduleSlackMessageResponse
+	17, // 20: jarvis.v1.JarvisService.ListUsers:output_type -> jarvis.v1.ListUsersResponse
+	19, // 21: jarvis.v1.JarvisService.LookupUserByEmail:output_type -> jarvis.v1.LookupUserByEmailResponse
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_jarvis_v1_service_proto_init() }
@@ -485,7 +1319,7 @@ func file_jarvis_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jarvis_v1_service_proto_rawDesc), len(file_jarvis_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
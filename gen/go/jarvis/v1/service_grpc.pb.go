@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JarvisService_ListInvitedChannels_FullMethodName = "/jarvis.v1.JarvisService/ListInvitedChannels"
-	JarvisService_SendSlackMessage_FullMethodName    = "/jarvis.v1.JarvisService/SendSlackMessage"
-	JarvisService_GetUserProfile_FullMethodName      = "/jarvis.v1.JarvisService/GetUserProfile"
+	JarvisService_ListInvitedChannels_FullMethodName  = "/jarvis.v1.JarvisService/ListInvitedChannels"
+	JarvisService_SendSlackMessage_FullMethodName     = "/jarvis.v1.JarvisService/SendSlackMessage"
+	JarvisService_GetUserProfile_FullMethodName       = "/jarvis.v1.JarvisService/GetUserProfile"
+	JarvisService_UpdateSlackMessage_FullMethodName   = "/jarvis.v1.JarvisService/UpdateSlackMessage"
+	JarvisService_DeleteSlackMessage_FullMethodName   = "/jarvis.v1.JarvisService/DeleteSlackMessage"
+	JarvisService_SendEphemeralMessage_FullMethodName = "/jarvis.v1.JarvisService/SendEphemeralMessage"
+	JarvisService_ScheduleSlackMessage_FullMethodName = "/jarvis.v1.JarvisService/ScheduleSlackMessage"
+	JarvisService_ListUsers_FullMethodName            = "/jarvis.v1.JarvisService/ListUsers"
+	JarvisService_LookupUserByEmail_FullMethodName    = "/jarvis.v1.JarvisService/LookupUserByEmail"
 )
 
 // JarvisServiceClient is the client API for JarvisService service.
@@ -31,6 +37,12 @@ type JarvisServiceClient interface {
 	ListInvitedChannels(ctx context.Context, in *ListInvitedChannelsRequest, opts ...grpc.CallOption) (*ListInvitedChannelsResponse, error)
 	SendSlackMessage(ctx context.Context, in *SendSlackMessageRequest, opts ...grpc.CallOption) (*SendSlackMessageResponse, error)
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
+	UpdateSlackMessage(ctx context.Context, in *UpdateSlackMessageRequest, opts ...grpc.CallOption) (*UpdateSlackMessageResponse, error)
+	DeleteSlackMessage(ctx context.Context, in *DeleteSlackMessageRequest, opts ...grpc.CallOption) (*DeleteSlackMessageResponse, error)
+	SendEphemeralMessage(ctx context.Context, in *SendEphemeralMessageRequest, opts ...grpc.CallOption) (*SendEphemeralMessageResponse, error)
+	ScheduleSlackMessage(ctx context.Context, in *ScheduleSlackMessageRequest, opts ...grpc.CallOption) (*ScheduleSlackMessageResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	LookupUserByEmail(ctx context.Context, in *LookupUserByEmailRequest, opts ...grpc.CallOption) (*LookupUserByEmailResponse, error)
 }
 
 type jarvisServiceClient struct {
@@ -71,6 +83,66 @@ func (c *jarvisServiceClient) GetUserProfile(ctx context.Context, in *GetUserPro
 	return out, nil
 }
 
+func (c *jarvisServiceClient) UpdateSlackMessage(ctx context.Context, in *UpdateSlackMessageRequest, opts ...grpc.CallOption) (*UpdateSlackMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSlackMessageResponse)
+	err := c.cc.Invoke(ctx, JarvisService_UpdateSlackMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisServiceClient) DeleteSlackMessage(ctx context.Context, in *DeleteSlackMessageRequest, opts ...grpc.CallOption) (*DeleteSlackMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSlackMessageResponse)
+	err := c.cc.Invoke(ctx, JarvisService_DeleteSlackMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisServiceClient) SendEphemeralMessage(ctx context.Context, in *SendEphemeralMessageRequest, opts ...grpc.CallOption) (*SendEphemeralMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEphemeralMessageResponse)
+	err := c.cc.Invoke(ctx, JarvisService_SendEphemeralMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisServiceClient) ScheduleSlackMessage(ctx context.Context, in *ScheduleSlackMessageRequest, opts ...grpc.CallOption) (*ScheduleSlackMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleSlackMessageResponse)
+	err := c.cc.Invoke(ctx, JarvisService_ScheduleSlackMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, JarvisService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jarvisServiceClient) LookupUserByEmail(ctx context.Context, in *LookupUserByEmailRequest, opts ...grpc.CallOption) (*LookupUserByEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupUserByEmailResponse)
+	err := c.cc.Invoke(ctx, JarvisService_LookupUserByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JarvisServiceServer is the server API for JarvisService service.
 // All implementations must embed UnimplementedJarvisServiceServer
 // for forward compatibility.
@@ -78,6 +150,12 @@ type JarvisServiceServer interface {
 	ListInvitedChannels(context.Context, *ListInvitedChannelsRequest) (*ListInvitedChannelsResponse, error)
 	SendSlackMessage(context.Context, *SendSlackMessageRequest) (*SendSlackMessageResponse, error)
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	UpdateSlackMessage(context.Context, *UpdateSlackMessageRequest) (*UpdateSlackMessageResponse, error)
+	DeleteSlackMessage(context.Context, *DeleteSlackMessageRequest) (*DeleteSlackMessageResponse, error)
+	SendEphemeralMessage(context.Context, *SendEphemeralMessageRequest) (*SendEphemeralMessageResponse, error)
+	ScheduleSlackMessage(context.Context, *ScheduleSlackMessageRequest) (*ScheduleSlackMessageResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	LookupUserByEmail(context.Context, *LookupUserByEmailRequest) (*LookupUserByEmailResponse, error)
 	mustEmbedUnimplementedJarvisServiceServer()
 }
 
@@ -97,6 +175,24 @@ func (UnimplementedJarvisServiceServer) SendSlackMessage(context.Context, *SendS
 func (UnimplementedJarvisServiceServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
 }
+func (UnimplementedJarvisServiceServer) UpdateSlackMessage(context.Context, *UpdateSlackMessageRequest) (*UpdateSlackMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlackMessage not implemented")
+}
+func (UnimplementedJarvisServiceServer) DeleteSlackMessage(context.Context, *DeleteSlackMessageRequest) (*DeleteSlackMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlackMessage not implemented")
+}
+func (UnimplementedJarvisServiceServer) SendEphemeralMessage(context.Context, *SendEphemeralMessageRequest) (*SendEphemeralMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEphemeralMessage not implemented")
+}
+func (UnimplementedJarvisServiceServer) ScheduleSlackMessage(context.Context, *ScheduleSlackMessageRequest) (*ScheduleSlackMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSlackMessage not implemented")
+}
+func (UnimplementedJarvisServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedJarvisServiceServer) LookupUserByEmail(context.Context, *LookupUserByEmailRequest) (*LookupUserByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupUserByEmail not implemented")
+}
 func (UnimplementedJarvisServiceServer) mustEmbedUnimplementedJarvisServiceServer() {}
 func (UnimplementedJarvisServiceServer) testEmbeddedByValue()                       {}
 
@@ -172,6 +268,114 @@ func _JarvisService_GetUserProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JarvisService_UpdateSlackMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSlackMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).UpdateSlackMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_UpdateSlackMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).UpdateSlackMessage(ctx, req.(*UpdateSlackMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisService_DeleteSlackMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSlackMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).DeleteSlackMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_DeleteSlackMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).DeleteSlackMessage(ctx, req.(*DeleteSlackMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisService_SendEphemeralMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEphemeralMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).SendEphemeralMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_SendEphemeralMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).SendEphemeralMessage(ctx, req.(*SendEphemeralMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisService_ScheduleSlackMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSlackMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).ScheduleSlackMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_ScheduleSlackMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).ScheduleSlackMessage(ctx, req.(*ScheduleSlackMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JarvisService_LookupUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JarvisServiceServer).LookupUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JarvisService_LookupUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JarvisServiceServer).LookupUserByEmail(ctx, req.(*LookupUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JarvisService_ServiceDesc is the grpc.ServiceDesc for JarvisService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +395,30 @@ var JarvisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserProfile",
 			Handler:    _JarvisService_GetUserProfile_Handler,
 		},
+		{
+			MethodName: "UpdateSlackMessage",
+			Handler:    _JarvisService_UpdateSlackMessage_Handler,
+		},
+		{
+			MethodName: "DeleteSlackMessage",
+			Handler:    _JarvisService_DeleteSlackMessage_Handler,
+		},
+		{
+			MethodName: "SendEphemeralMessage",
+			Handler:    _JarvisService_SendEphemeralMessage_Handler,
+		},
+		{
+			MethodName: "ScheduleSlackMessage",
+			Handler:    _JarvisService_ScheduleSlackMessage_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _JarvisService_ListUsers_Handler,
+		},
+		{
+			MethodName: "LookupUserByEmail",
+			Handler:    _JarvisService_LookupUserByEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jarvis/v1/service.proto",
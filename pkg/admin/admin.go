@@ -0,0 +1,132 @@
+// 쿠버네티스의 프로브와 Prometheus 가 사용하는 관리용 HTTP 서버.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/metrics"
+)
+
+// ADMIN_ADDR 가 없을 때 사용하는 주소.
+const DefaultAddr = ":9090"
+
+// 관리용 서버의 주소. ADMIN_ADDR 가 없으면 DefaultAddr 를 사용한다.
+func Addr() string {
+	if addr, ok := os.LookupEnv("ADMIN_ADDR"); ok {
+		return addr
+	}
+	return DefaultAddr
+}
+
+// 준비 상태를 확인하는 함수. 요청을 받을 수 없으면 이유를 담은 오류를 반환한다.
+type Check func() error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+type serverOptions struct {
+	registry *metrics.Registry
+	checks   []namedCheck
+}
+
+type Option func(*serverOptions)
+
+// /metrics 로 노출할 지표를 지정한다. 지정하지 않으면 /metrics 를 제공하지 않는다.
+func WithRegistry(registry *metrics.Registry) Option {
+	return func(opts *serverOptions) {
+		opts.registry = registry
+	}
+}
+
+// /readyz 에서 확인할 항목을 추가한다. 모든 항목이 통과해야 준비된 것으로 응답한다.
+func WithReadyCheck(name string, check Check) Option {
+	return func(opts *serverOptions) {
+		opts.checks = append(opts.checks, namedCheck{name: name, check: check})
+	}
+}
+
+// /healthz, /readyz, /metrics 를 제공하는 서버.
+type Server struct {
+	options *serverOptions
+}
+
+func NewServer(opts ...Option) *Server {
+	options := &serverOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Server{options: options}
+}
+
+// 관리용 엔드포인트를 처리하는 핸들러.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	// 프로세스가 응답할 수 있으면 살아있는 것으로 본다.
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("GET /readyz", s.serveReady)
+	if s.options.registry != nil {
+		mux.Handle("GET /metrics", s.options.registry.Handler())
+	}
+	return mux
+}
+
+// 준비되지 않은 항목이 있으면 503 으로 응답하고, 항목마다 결과를 한 줄씩 보여준다.
+func (s *Server) serveReady(w http.ResponseWriter, _ *http.Request) {
+	var (
+		body  strings.Builder
+		ready = true
+	)
+	for _, c := range s.options.checks {
+		if err := c.check(); err != nil {
+			ready = false
+			fmt.Fprintf(&body, "%s: %v\n", c.name, err)
+			continue
+		}
+		fmt.Fprintf(&body, "%s: ok\n", c.name)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = fmt.Fprint(w, body.String())
+}
+
+// addr 에서 관리용 엔드포인트를 제공한다. (e.g. ":9090")
+// ctx 가 종료되면 처리중인 요청을 마친 뒤 반환한다.
+func (s *Server) Serve(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("failed to shutdown admin server", slog.Any("error", err))
+		}
+	}()
+
+	if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package admin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/admin"
+	"github.com/joyfuldevs/project-jarvis/pkg/metrics"
+)
+
+func TestServerHandler(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("events_total", "Number of events.").Inc()
+
+	var connected bool
+	handler := admin.NewServer(
+		admin.WithRegistry(registry),
+		admin.WithReadyCheck("grpc", func() error { return nil }),
+		admin.WithReadyCheck("slack", func() error {
+			if !connected {
+				return errors.New("bot is connecting")
+			}
+			return nil
+		}),
+	).Handler()
+
+	testCases := []struct {
+		desc       string
+		path       string
+		connected  bool
+		wantStatus int
+		wantBody   string
+	}{
+		{desc: "healthz", path: "/healthz", wantStatus: http.StatusOK, wantBody: "ok\n"},
+		{desc: "not ready", path: "/readyz", wantStatus: http.StatusServiceUnavailable, wantBody: "grpc: ok\nslack: bot is connecting\n"},
+		{desc: "ready", path: "/readyz", connected: true, wantStatus: http.StatusOK, wantBody: "grpc: ok\nslack: ok\n"},
+		{desc: "metrics", path: "/metrics", wantStatus: http.StatusOK, wantBody: "events_total 1\n"},
+		{desc: "unknown path", path: "/debug", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			connected = tc.connected
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			if rec.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, rec.Code)
+			}
+			if !strings.HasSuffix(rec.Body.String(), tc.wantBody) {
+				t.Errorf("expected body ending with %q, got %q", tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServerWithoutRegistry(t *testing.T) {
+	rec := httptest.NewRecorder()
+	admin.NewServer().Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
+
+func TestAddr(t *testing.T) {
+	t.Setenv("ADMIN_ADDR", ":9191")
+	if got := admin.Addr(); got != ":9191" {
+		t.Errorf("expected %q, got %q", ":9191", got)
+	}
+}
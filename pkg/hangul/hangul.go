@@ -0,0 +1,84 @@
+// 한글 음절을 자모 단위로 다루는 함수를 제공한다.
+package hangul
+
+import "unicode/utf8"
+
+const (
+	syllableBase  = 0xAC00
+	syllableLast  = 0xD7A3
+	choseongBase  = 0x1100
+	jungseongBase = 0x1161
+	jongseongBase = 0x11A7
+
+	jungseongCount = 21
+	jongseongCount = 28
+)
+
+// 한글 음절인지 확인한다. (가 ~ 힣)
+func IsSyllable(r rune) bool {
+	return r >= syllableBase && r <= syllableLast
+}
+
+// 한글 음절을 초성, 중성, 종성 자모로 분해한다. 한글 음절이 아닌 문자는 그대로 둔다.
+// (e.g. "날" → U+1102 U+1161 U+11AF)
+func Decompose(s string) []rune {
+	jamo := make([]rune, 0, utf8.RuneCountInString(s)*3)
+	for _, r := range s {
+		if !IsSyllable(r) {
+			jamo = append(jamo, r)
+			continue
+		}
+		index := r - syllableBase
+		jamo = append(jamo,
+			choseongBase+index/(jungseongCount*jongseongCount),
+			jungseongBase+index%(jungseongCount*jongseongCount)/jongseongCount,
+		)
+		if jong := index % jongseongCount; jong > 0 {
+			jamo = append(jamo, jongseongBase+jong)
+		}
+	}
+	return jamo
+}
+
+// 두 문자열을 자모로 분해해 편집 거리를 계산한다.
+// 음절 단위로 비교하면 "날시" 와 "날씨" 처럼 받침이나 자음 하나만 다른 오타도 한 글자가 다르지만,
+// 자모 단위로 비교하면 실제로 잘못 입력한 자모의 수에 가까운 거리를 얻을 수 있다.
+func Distance(a, b string) int {
+	return levenshtein(Decompose(a), Decompose(b))
+}
+
+// candidates 중에서 word 와 자모 단위로 가장 비슷한 문자열의 인덱스를 찾는다.
+// 긴 쪽 자모 수의 절반보다 많이 다르면 비슷하지 않은 것으로 보고, 거리가 같으면 앞의 것을 고른다.
+// 비슷한 문자열이 없으면 false 를 반환한다. (e.g. "날시" → "날씨")
+func Closest(word string, candidates []string) (int, bool) {
+	closest, best := -1, 0
+	for i, candidate := range candidates {
+		distance := Distance(word, candidate)
+		limit := max(len(Decompose(word)), len(Decompose(candidate))) / 2
+		if distance > limit || (closest >= 0 && distance >= best) {
+			continue
+		}
+		closest, best = i, distance
+	}
+	return closest, closest >= 0
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,74 @@
+package hangul_test
+
+import (
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/hangul"
+)
+
+func TestDecompose(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input string
+		want  string
+	}{
+		{desc: "without final consonant", input: "씨", want: "\u110A\u1175"},
+		{desc: "with final consonant", input: "날", want: "\u1102\u1161\u11AF"},
+		{desc: "mixed", input: "a날", want: "a\u1102\u1161\u11AF"},
+		{desc: "compatibility jamo is kept", input: "ㄱ", want: "ㄱ"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := string(hangul.Decompose(tc.input)); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	testCases := []struct {
+		desc string
+		a    string
+		b    string
+		want int
+	}{
+		{desc: "same", a: "날씨", b: "날씨", want: 0},
+		{desc: "consonant typo", a: "날시", b: "날씨", want: 1},
+		{desc: "missing final consonant", a: "나씨", b: "날씨", want: 1},
+		{desc: "missing syllable", a: "공휴", b: "공휴일", want: 3},
+		{desc: "latin", a: "wether", b: "weather", want: 1},
+		{desc: "empty", a: "", b: "날씨", want: 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := hangul.Distance(tc.a, tc.b); got != tc.want {
+				t.Errorf("expected %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"날씨", "날짜", "공휴일"}
+	testCases := []struct {
+		desc   string
+		word   string
+		want   int
+		wantOK bool
+	}{
+		{desc: "exact", word: "공휴일", want: 2, wantOK: true},
+		{desc: "consonant typo", word: "날시", want: 0, wantOK: true},
+		{desc: "tie picks first", word: "날찌", want: 0, wantOK: true},
+		{desc: "too different", word: "회의실", want: -1, wantOK: false},
+		{desc: "empty", word: "", want: -1, wantOK: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, ok := hangul.Closest(tc.word, candidates)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("expected (%d, %t), got (%d, %t)", tc.want, tc.wantOK, got, ok)
+			}
+		})
+	}
+}
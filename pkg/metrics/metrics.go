@@ -0,0 +1,295 @@
+// 외부 의존성 없이 Prometheus 텍스트 형식으로 노출할 수 있는 카운터와 히스토그램.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 요청 처리 시간(초)을 기록할 때 사용하는 기본 구간.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// 지표 하나를 텍스트 형식으로 기록한다.
+type collector interface {
+	write(w io.Writer)
+}
+
+// 지표를 모아서 /metrics 로 노출한다.
+type Registry struct {
+	mu         sync.Mutex
+	names      map[string]bool
+	collectors []collector
+}
+
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+func (r *Registry) register(name string, c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[name] {
+		panic(fmt.Sprintf("metrics: duplicated metric %q", name))
+	}
+	r.names[name] = true
+	r.collectors = append(r.collectors, c)
+}
+
+// 등록된 모든 지표를 등록한 순서대로 기록한다.
+func (r *Registry) Write(w io.Writer) {
+	r.mu.Lock()
+	collectors := slices.Clone(r.collectors)
+	r.mu.Unlock()
+	for _, c := range collectors {
+		c.write(w)
+	}
+}
+
+// Prometheus 가 수집할 수 있는 텍스트 형식으로 지표를 응답한다.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.Write(w)
+	})
+}
+
+// 레이블 값의 조합마다 값을 따로 관리하는 지표.
+type vec[T any] struct {
+	name   string
+	help   string
+	labels []string
+
+	mu     sync.Mutex
+	series map[string]*T
+	newT   func() *T
+}
+
+func (v *vec[T]) with(values []string) *T {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s, ok := v.series[key]
+	if !ok {
+		s = v.newT()
+		v.series[key] = s
+	}
+	return s
+}
+
+// 레이블 값 순서로 정렬한 시계열을 순회한다.
+func (v *vec[T]) each(fn func(values []string, s *T)) {
+	v.mu.Lock()
+	keys := make([]string, 0, len(v.series))
+	series := make(map[string]*T, len(v.series))
+	for key, s := range v.series {
+		keys = append(keys, key)
+		series[key] = s
+	}
+	v.mu.Unlock()
+
+	slices.Sort(keys)
+	for _, key := range keys {
+		var values []string
+		if len(v.labels) > 0 {
+			values = strings.Split(key, "\xff")
+		}
+		fn(values, series[key])
+	}
+}
+
+func (v *vec[T]) writeHeader(w io.Writer, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", v.name, escapeHelp(v.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", v.name, kind)
+}
+
+// 증가하기만 하는 값.
+type CounterVec struct {
+	vec[counter]
+}
+
+type counter struct {
+	mu    sync.Mutex
+	value float64
+}
+
+// 레이블 이름을 지정해서 카운터를 만들고 등록한다.
+func (r *Registry) NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	c := &CounterVec{vec[counter]{
+		name:   name,
+		help:   help,
+		labels: labels,
+		series: make(map[string]*counter),
+		newT:   func() *counter { return &counter{} },
+	}}
+	r.register(name, c)
+	return c
+}
+
+// 레이블 값에 해당하는 카운터를 1 증가시킨다.
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// 레이블 값에 해당하는 카운터를 delta 만큼 증가시킨다. 음수는 무시한다.
+func (c *CounterVec) Add(delta float64, values ...string) {
+	if delta < 0 {
+		return
+	}
+	s := c.with(values)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.value += delta
+}
+
+// 레이블 값에 해당하는 카운터의 현재 값.
+func (c *CounterVec) Value(values ...string) float64 {
+	s := c.with(values)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.value
+}
+
+func (c *CounterVec) write(w io.Writer) {
+	c.writeHeader(w, "counter")
+	c.each(func(values []string, s *counter) {
+		s.mu.Lock()
+		value := s.value
+		s.mu.Unlock()
+		fmt.Fprintf(w, "%s%s %s\n", c.name, formatLabels(c.labels, values), formatFloat(value))
+	})
+}
+
+// 값의 분포를 구간별 개수로 기록한다.
+type HistogramVec struct {
+	vec[histogram]
+	buckets []float64
+}
+
+type histogram struct {
+	mu     sync.Mutex
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// 구간의 상한과 레이블 이름을 지정해서 히스토그램을 만들고 등록한다. buckets 가 비어있으면 DefaultBuckets 를 사용한다.
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	h := &HistogramVec{
+		vec: vec[histogram]{
+			name:   name,
+			help:   help,
+			labels: labels,
+			series: make(map[string]*histogram),
+			newT:   func() *histogram { return &histogram{counts: make([]uint64, len(buckets))} },
+		},
+		buckets: buckets,
+	}
+	r.register(name, h)
+	return h
+}
+
+// 레이블 값에 해당하는 히스토그램에 값을 기록한다.
+func (h *HistogramVec) Observe(value float64, values ...string) {
+	s := h.with(values)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i, _ := slices.BinarySearch(h.buckets, value); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += value
+}
+
+// 걸린 시간을 초 단위로 기록한다.
+func (h *HistogramVec) ObserveDuration(d time.Duration, values ...string) {
+	h.Observe(d.Seconds(), values...)
+}
+
+// 레이블 값에 해당하는 히스토그램에 기록된 값의 개수.
+func (h *HistogramVec) Count(values ...string) uint64 {
+	s := h.with(values)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+func (h *HistogramVec) write(w io.Writer) {
+	h.writeHeader(w, "histogram")
+	labels := append(slices.Clone(h.labels), "le")
+	h.each(func(values []string, s *histogram) {
+		s.mu.Lock()
+		counts := slices.Clone(s.counts)
+		count, sum := s.count, s.sum
+		s.mu.Unlock()
+
+		// 각 구간에는 상한 이하인 값의 누적 개수를 기록한다.
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += counts[i]
+			bucket := formatLabels(labels, append(slices.Clone(values), formatFloat(upper)))
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, bucket, cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, formatLabels(labels, append(slices.Clone(values), "+Inf")), count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, formatLabels(h.labels, values), formatFloat(sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, formatLabels(h.labels, values), count)
+	})
+}
+
+func formatLabels(labels []string, values []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	var builder strings.Builder
+	builder.WriteByte('{')
+	for i, label := range labels {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(label)
+		builder.WriteString(`="`)
+		builder.WriteString(escapeLabelValue(values[i]))
+		builder.WriteByte('"')
+	}
+	builder.WriteByte('}')
+	return builder.String()
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+var (
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabelValue(s string) string {
+	return labelValueReplacer.Replace(s)
+}
+
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
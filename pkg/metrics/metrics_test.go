@@ -0,0 +1,96 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/metrics"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	registry := metrics.NewRegistry()
+	calls := registry.NewCounterVec("api_calls_total", "Number of API calls.", "method", "status")
+	duration := registry.NewHistogramVec("api_call_duration_seconds", "API call latency.", []float64{0.5, 0.1}, "method")
+
+	calls.Inc("chat.postMessage", "ok")
+	calls.Add(2, "chat.postMessage", "ok")
+	calls.Inc("apps.connections.open", `invalid "auth"`)
+	calls.Add(-1, "apps.connections.open", `invalid "auth"`)
+	duration.Observe(0.05, "chat.postMessage")
+	duration.ObserveDuration(300*time.Millisecond, "chat.postMessage")
+	duration.Observe(3, "chat.postMessage")
+
+	var builder strings.Builder
+	registry.Write(&builder)
+
+	want := `# HELP api_calls_total Number of API calls.
+# TYPE api_calls_total counter
+api_calls_total{method="apps.connections.open",status="invalid \"auth\""} 1
+api_calls_total{method="chat.postMessage",status="ok"} 3
+# HELP api_call_duration_seconds API call latency.
+# TYPE api_call_duration_seconds histogram
+api_call_duration_seconds_bucket{method="chat.postMessage",le="0.1"} 1
+api_call_duration_seconds_bucket{method="chat.postMessage",le="0.5"} 2
+api_call_duration_seconds_bucket{method="chat.postMessage",le="+Inf"} 3
+api_call_duration_seconds_sum{method="chat.postMessage"} 3.35
+api_call_duration_seconds_count{method="chat.postMessage"} 3
+`
+	if got := builder.String(); got != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, got)
+	}
+	if got := calls.Value("chat.postMessage", "ok"); got != 3 {
+		t.Errorf("unexpected counter value: %v", got)
+	}
+	if got := duration.Count("chat.postMessage"); got != 3 {
+		t.Errorf("unexpected histogram count: %v", got)
+	}
+}
+
+func TestRegistryHandler(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("events_total", "Number of events.").Inc()
+
+	rec := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type: %s", rec.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rec.Body.String(), "\nevents_total 1\n") {
+		t.Errorf("unexpected body: %s", rec.Body.String())
+	}
+}
+
+func TestRegistryPanics(t *testing.T) {
+	testCases := []struct {
+		desc string
+		fn   func(registry *metrics.Registry)
+	}{
+		{
+			desc: "duplicated metric",
+			fn: func(registry *metrics.Registry) {
+				registry.NewCounterVec("events_total", "")
+				registry.NewHistogramVec("events_total", "", nil)
+			},
+		},
+		{
+			desc: "wrong number of label values",
+			fn: func(registry *metrics.Registry) {
+				registry.NewCounterVec("events_total", "", "kind").Inc("command", "extra")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic")
+				}
+			}()
+			tc.fn(metrics.NewRegistry())
+		})
+	}
+}
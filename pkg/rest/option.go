@@ -12,6 +12,7 @@ type options struct {
 	params     string
 	headers    map[string]string
 	body       io.Reader
+	hooks      []func(req *http.Request)
 }
 
 type Option func(*options)
@@ -43,3 +44,10 @@ func WithHTTPClient(client http.Client) Option {
 		o.httpClient = client
 	}
 }
+
+// 요청을 보내기 직전에 호출할 함수를 추가한다. 헤더를 추가하거나 요청을 기록하는 데 사용한다.
+func WithRequestHook(hook func(req *http.Request)) Option {
+	return func(o *options) {
+		o.hooks = append(o.hooks, hook)
+	}
+}
@@ -2,12 +2,27 @@ package rest
 
 import (
 	"context"
-	"errors"
 	"io"
 	"net/http"
 	"time"
 )
 
+// 2xx 이외의 상태 코드로 응답한 경우 반환되는 오류.
+// 호출하는 쪽에서 상태 코드나 Retry-After 와 같은 헤더를 확인할 수 있도록 응답을 그대로 담는다.
+type ResponseError struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+func (e *ResponseError) Error() string {
+	if len(e.Body) > 0 {
+		return string(e.Body)
+	}
+	return e.Status
+}
+
 type Client struct {
 	Domain string
 }
@@ -40,6 +55,9 @@ func (c *Client) RequestAPI(
 	for key, value := range options.headers {
 		req.Header.Add(key, value)
 	}
+	for _, hook := range options.hooks {
+		hook(req)
+	}
 
 	resp, err := options.httpClient.Do(req)
 	if err != nil {
@@ -53,10 +71,11 @@ func (c *Client) RequestAPI(
 	_ = resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		if len(data) > 0 {
-			return nil, errors.New(string(data))
-		} else {
-			return nil, errors.New(resp.Status)
+		return nil, &ResponseError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Header:     resp.Header,
+			Body:       data,
 		}
 	}
 
@@ -2,6 +2,7 @@ package rest_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -96,3 +97,39 @@ func TestClientRequestAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestClientRequestAPIResponseError(t *testing.T) {
+	client := http.Client{
+		Transport: &mockRoundTripper{
+			ResponseFunc: func(req *http.Request) (*http.Response, error) {
+				header := make(http.Header)
+				header.Set("Retry-After", "30")
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Status:     "429 Too Many Requests",
+					Body:       io.NopCloser(strings.NewReader("ratelimited")),
+					Header:     header,
+				}, nil
+			},
+		},
+	}
+
+	_, err := rest.NewClient("https://example.com").RequestAPI(
+		context.Background(), "GET", "",
+		rest.WithHTTPClient(client),
+	)
+
+	var respErr *rest.ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("expected *rest.ResponseError, got %v", err)
+	}
+	if respErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("unexpected status code: %d", respErr.StatusCode)
+	}
+	if got := respErr.Header.Get("Retry-After"); got != "30" {
+		t.Errorf("unexpected Retry-After: %q", got)
+	}
+	if err.Error() != "ratelimited" {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+}
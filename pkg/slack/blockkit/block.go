@@ -2,6 +2,7 @@ package blockkit
 
 import (
 	"encoding/json"
+	"errors"
 )
 
 // Reference
@@ -10,10 +11,16 @@ import (
 type BlockType string
 
 const (
-	BlockTypeSection BlockType = "section"
-	BlockTypeHeader  BlockType = "header"
-	BlockTypeAction  BlockType = "actions"
-	BlockTypeDivider BlockType = "divider"
+	BlockTypeSection  BlockType = "section"
+	BlockTypeHeader   BlockType = "header"
+	BlockTypeAction   BlockType = "actions"
+	BlockTypeDivider  BlockType = "divider"
+	BlockTypeContext  BlockType = "context"
+	BlockTypeImage    BlockType = "image"
+	BlockTypeInput    BlockType = "input"
+	BlockTypeRichText BlockType = "rich_text"
+	BlockTypeVideo    BlockType = "video"
+	BlockTypeFile     BlockType = "file"
 )
 
 type SlackBlock interface {
@@ -96,6 +103,29 @@ func (s *SectionBlock) MarshalJSON() ([]byte, error) {
 	return json.Marshal(raw)
 }
 
+func (s *SectionBlock) UnmarshalJSON(data []byte) error {
+	type block SectionBlock
+	raw := struct {
+		*block
+		Accessory json.RawMessage `json:"accessory"`
+	}{
+		block: (*block)(s),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	s.Accessory = nil
+	if !isNull(raw.Accessory) {
+		accessory, err := UnmarshalElement(raw.Accessory)
+		if err != nil {
+			return err
+		}
+		s.Accessory = accessory
+	}
+	return nil
+}
+
 // Holds multiple interactive elements.
 type ActionBlock struct {
 	// A unique identifier for a block. If not specified, one will be generated.
@@ -123,6 +153,26 @@ func (a *ActionBlock) MarshalJSON() ([]byte, error) {
 	return json.Marshal(raw)
 }
 
+func (a *ActionBlock) UnmarshalJSON(data []byte) error {
+	type block ActionBlock
+	raw := struct {
+		*block
+		Elements json.RawMessage `json:"elements"`
+	}{
+		block: (*block)(a),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := UnmarshalElements(raw.Elements)
+	if err != nil {
+		return err
+	}
+	a.Elements = elements
+	return nil
+}
+
 type DividerBlock struct {
 }
 
@@ -138,3 +188,250 @@ func (d *DividerBlock) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+
+// Displays contextual info, which can include both images and text.
+type ContextBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// An array of image elements and text objects. Maximum number of items is 10.
+	Elements []ContextElement `json:"elements"`
+}
+
+// Context 블록에 포함될 수 있는 요소. *TextObject 또는 *ImageElement 를 사용한다.
+type ContextElement interface {
+	isContextElement()
+}
+
+func (t *TextObject) isContextElement() {}
+
+func (i *ImageElement) isContextElement() {}
+
+func NewContextBlock(texts ...string) *ContextBlock {
+	elements := make([]ContextElement, 0, len(texts))
+	for _, text := range texts {
+		elements = append(elements, &TextObject{
+			Type: TextTypeMarkdown,
+			Text: text,
+		})
+	}
+	return &ContextBlock{Elements: elements}
+}
+
+func (c *ContextBlock) BlockType() BlockType {
+	return BlockTypeContext
+}
+
+func (c *ContextBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ContextBlock
+		Type BlockType `json:"type"`
+	}{
+		ContextBlock: *c,
+		Type:         c.BlockType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (c *ContextBlock) UnmarshalJSON(data []byte) error {
+	type block ContextBlock
+	raw := struct {
+		*block
+		Elements []json.RawMessage `json:"elements"`
+	}{
+		block: (*block)(c),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Elements = make([]ContextElement, 0, len(raw.Elements))
+	for _, data := range raw.Elements {
+		var element = struct {
+			Type string `json:"type"`
+		}{}
+		if err := json.Unmarshal(data, &element); err != nil {
+			return err
+		}
+
+		var value ContextElement
+		switch element.Type {
+		case string(TextTypePlainText), string(TextTypeMarkdown):
+			value = &TextObject{}
+		case string(ElementTypeImage):
+			value = &ImageElement{}
+		default:
+			return errors.New("undefined context element type: " + element.Type)
+		}
+		if err := json.Unmarshal(data, value); err != nil {
+			return err
+		}
+		c.Elements = append(c.Elements, value)
+	}
+	return nil
+}
+
+// Displays an image.
+type ImageBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// A plain-text summary of the image. This should not contain any markup.
+	// Maximum length for this field is 2000 characters.
+	AltText string `json:"alt_text"`
+	// The URL for a publicly hosted image. You must provide either an image_url or slack_file.
+	// Maximum length for this field is 3000 characters.
+	ImageURL string `json:"image_url,omitempty"`
+	// A Slack image file object that defines the source of the image.
+	SlackFile *SlackFileObject `json:"slack_file,omitempty"`
+	// An optional title for the image in the form of a `plain_text` text object.
+	// Maximum length for the text in this field is 2000 characters.
+	Title *TextObject `json:"title,omitempty"`
+}
+
+func (i *ImageBlock) BlockType() BlockType {
+	return BlockTypeImage
+}
+
+func (i *ImageBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ImageBlock
+		Type BlockType `json:"type"`
+	}{
+		ImageBlock: *i,
+		Type:       i.BlockType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Collects information from users via block elements.
+type InputBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// A label that appears above an input element in the form of a `plain_text` text object.
+	// Maximum length for the text in this field is 2000 characters.
+	Label TextObject `json:"label"`
+	// A block element. (e.g. plain_text_input, checkboxes, static_select)
+	Element SlackBlockElement `json:"element"`
+	// A boolean that indicates whether or not the use of elements in this block
+	// should dispatch a block_actions payload. Defaults to false.
+	DispatchAction bool `json:"dispatch_action,omitempty"`
+	// An optional hint that appears below an input element in a lighter grey.
+	// It must be a `plain_text` text object. Maximum length for the text in this field is 2000 characters.
+	Hint *TextObject `json:"hint,omitempty"`
+	// A boolean that indicates whether the input element may be empty when a user submits the modal.
+	// Defaults to false.
+	Optional bool `json:"optional,omitempty"`
+}
+
+func (i *InputBlock) BlockType() BlockType {
+	return BlockTypeInput
+}
+
+func (i *InputBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		InputBlock
+		Type BlockType `json:"type"`
+	}{
+		InputBlock: *i,
+		Type:       i.BlockType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (i *InputBlock) UnmarshalJSON(data []byte) error {
+	type block InputBlock
+	raw := struct {
+		*block
+		Element json.RawMessage `json:"element"`
+	}{
+		block: (*block)(i),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	i.Element = nil
+	if !isNull(raw.Element) {
+		element, err := UnmarshalElement(raw.Element)
+		if err != nil {
+			return err
+		}
+		i.Element = element
+	}
+	return nil
+}
+
+// Displays an embedded video player.
+// A video block is designed to embed videos in all app surfaces
+// (e.g. link unfurls, messages, modals, App Home).
+type VideoBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// A tooltip for the video. Required for accessibility.
+	AltText string `json:"alt_text"`
+	// Video title in the form of a `plain_text` text object.
+	// Maximum length for the text in this field is 200 characters.
+	Title TextObject `json:"title"`
+	// Hyperlink for the title text. Must correspond to the non-embeddable URL for the video.
+	// Must go to an HTTPS URL.
+	TitleURL string `json:"title_url,omitempty"`
+	// Description for video in the form of a `plain_text` text object.
+	Description *TextObject `json:"description,omitempty"`
+	// The thumbnail image URL.
+	ThumbnailURL string `json:"thumbnail_url"`
+	// The URL to be embedded. Must match any existing unfurl domains within the app
+	// and point to a HTTPS URL.
+	VideoURL string `json:"video_url"`
+	// Author name to be displayed. Must be less than 50 characters.
+	AuthorName string `json:"author_name,omitempty"`
+	// The originating application or domain of the video. (e.g. YouTube)
+	ProviderName string `json:"provider_name,omitempty"`
+	// Icon for the video provider. (e.g. YouTube icon)
+	ProviderIconURL string `json:"provider_icon_url,omitempty"`
+}
+
+func (v *VideoBlock) BlockType() BlockType {
+	return BlockTypeVideo
+}
+
+func (v *VideoBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		VideoBlock
+		Type BlockType `json:"type"`
+	}{
+		VideoBlock: *v,
+		Type:       v.BlockType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Displays info about remote files.
+// You can't add this block to app surfaces directly,
+// but it will show up when retrieving messages that contain remote files.
+type FileBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// The external unique ID for this file.
+	ExternalID string `json:"external_id"`
+	// At the moment, source will always be `remote` for a remote file.
+	Source string `json:"source"`
+}
+
+func (f *FileBlock) BlockType() BlockType {
+	return BlockTypeFile
+}
+
+func (f *FileBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		FileBlock
+		Type BlockType `json:"type"`
+	}{
+		FileBlock: *f,
+		Type:      f.BlockType(),
+	}
+	return json.Marshal(raw)
+}
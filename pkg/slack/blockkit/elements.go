@@ -10,9 +10,28 @@ import (
 type ElementType string
 
 const (
-	ElementTypeImage  ElementType = "image"
-	ElementTypeButton ElementType = "button"
-	ElementTypeSelect ElementType = "static_select"
+	ElementTypeImage                    ElementType = "image"
+	ElementTypeButton                   ElementType = "button"
+	ElementTypeSelect                   ElementType = "static_select"
+	ElementTypeExternalSelect           ElementType = "external_select"
+	ElementTypeUsersSelect              ElementType = "users_select"
+	ElementTypeConversationsSelect      ElementType = "conversations_select"
+	ElementTypeChannelsSelect           ElementType = "channels_select"
+	ElementTypeMultiStaticSelect        ElementType = "multi_static_select"
+	ElementTypeMultiExternalSelect      ElementType = "multi_external_select"
+	ElementTypeMultiUsersSelect         ElementType = "multi_users_select"
+	ElementTypeMultiConversationsSelect ElementType = "multi_conversations_select"
+	ElementTypeMultiChannelsSelect      ElementType = "multi_channels_select"
+	ElementTypeCheckboxes               ElementType = "checkboxes"
+	ElementTypeRadioButtons             ElementType = "radio_buttons"
+	ElementTypeOverflow                 ElementType = "overflow"
+	ElementTypeDatePicker               ElementType = "datepicker"
+	ElementTypeTimePicker               ElementType = "timepicker"
+	ElementTypeDateTimePicker           ElementType = "datetimepicker"
+	ElementTypePlainTextInput           ElementType = "plain_text_input"
+	ElementTypeEmailTextInput           ElementType = "email_text_input"
+	ElementTypeURLTextInput             ElementType = "url_text_input"
+	ElementTypeNumberInput              ElementType = "number_input"
 )
 
 // Block elements can be added to certain app surfaces and used within certain block types.
@@ -20,17 +39,33 @@ type SlackBlockElement interface {
 	ElementType() ElementType
 }
 
-// SelectElement 타입 추가
+// Allows users to choose an option from a drop down menu, populated with a static list of options.
 type SelectElement struct {
-	ActionID    string              `json:"action_id"`
-	Type        ElementType         `json:"type"`
-	Placeholder TextObject          `json:"placeholder"`
-	Options     []OptionBlockObject `json:"options"`
-}
-
-type OptionBlockObject struct {
-	Text  TextObject `json:"text"`
-	Value string     `json:"value"`
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// 인코딩할 때는 항상 static_select 를 사용한다.
+	Type ElementType `json:"type"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder TextObject `json:"placeholder"`
+	// An array of option objects. Maximum number of options is 100.
+	// If option_groups is specified, this field should not be.
+	Options []OptionBlockObject `json:"options,omitempty"`
+	// An array of option group objects. Maximum number of option groups is 100.
+	// If options is specified, this field should not be.
+	OptionGroups []OptionGroupObject `json:"option_groups,omitempty"`
+	// A single option that exactly matches one of the options within options or option_groups.
+	// This option will be selected when the menu initially loads.
+	InitialOption *OptionBlockObject `json:"initial_option,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
 }
 
 func (s *SelectElement) ElementType() ElementType {
@@ -38,17 +73,9 @@ func (s *SelectElement) ElementType() ElementType {
 }
 
 func (s *SelectElement) MarshalJSON() ([]byte, error) {
-	raw := struct {
-		ActionID    string              `json:"action_id"`
-		Type        ElementType         `json:"type"`
-		Placeholder TextObject          `json:"placeholder"`
-		Options     []OptionBlockObject `json:"options"`
-	}{
-		ActionID:    s.ActionID,
-		Type:        s.Type,
-		Placeholder: s.Placeholder,
-		Options:     s.Options,
-	}
+	type element SelectElement
+	raw := element(*s)
+	raw.Type = s.ElementType()
 	return json.Marshal(raw)
 }
 
@@ -100,6 +127,8 @@ type ButtonElement struct {
 	// This label will be read out by screen readers instead of the button text object.
 	// Maximum length is 75 characters.
 	Label string `json:"accessibility_label,omitempty"`
+	// A confirm object that defines an optional confirmation dialog after the button is clicked.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
 }
 
 func (b *ButtonElement) ElementType() ElementType {
@@ -116,3 +145,104 @@ func (b *ButtonElement) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+
+// Allows users to choose multiple items from a list of options.
+type CheckboxesElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. A maximum of 10 options are allowed.
+	Options []OptionBlockObject `json:"options"`
+	// An array of option objects that exactly matches one or more of the options within options.
+	// These options will be selected when the checkbox group initially loads.
+	InitialOptions []OptionBlockObject `json:"initial_options,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+}
+
+func (c *CheckboxesElement) ElementType() ElementType {
+	return ElementTypeCheckboxes
+}
+
+func (c *CheckboxesElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		CheckboxesElement
+		Type ElementType `json:"type"`
+	}{
+		CheckboxesElement: *c,
+		Type:              c.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose one item from a list of possible options.
+type RadioButtonsElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. A maximum of 10 options are allowed.
+	Options []OptionBlockObject `json:"options"`
+	// An option object that exactly matches one of the options within options.
+	// This option will be selected when the radio button group initially loads.
+	InitialOption *OptionBlockObject `json:"initial_option,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+}
+
+func (r *RadioButtonsElement) ElementType() ElementType {
+	return ElementTypeRadioButtons
+}
+
+func (r *RadioButtonsElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RadioButtonsElement
+		Type ElementType `json:"type"`
+	}{
+		RadioButtonsElement: *r,
+		Type:                r.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to press a button to view a list of options.
+// Unlike the select menu, there is no typeahead field,
+// and the button always appears with an ellipsis ("…") rather than customizable text.
+type OverflowElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of up to five option objects to display in the menu.
+	Options []OptionBlockObject `json:"options"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+}
+
+func (o *OverflowElement) ElementType() ElementType {
+	return ElementTypeOverflow
+}
+
+func (o *OverflowElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		OverflowElement
+		Type ElementType `json:"type"`
+	}{
+		OverflowElement: *o,
+		Type:            o.ElementType(),
+	}
+	return json.Marshal(raw)
+}
@@ -0,0 +1,275 @@
+package blockkit
+
+import (
+	"encoding/json"
+)
+
+// Reference
+// https://api.slack.com/reference/block-kit/block-elements#datepicker
+// https://api.slack.com/reference/block-kit/block-elements#input
+
+// Allows users to select a date from a calendar style UI.
+type DatePickerElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial date that is selected when the element is loaded.
+	// This should be in the format YYYY-MM-DD.
+	InitialDate string `json:"initial_date,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (d *DatePickerElement) ElementType() ElementType {
+	return ElementTypeDatePicker
+}
+
+func (d *DatePickerElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		DatePickerElement
+		Type ElementType `json:"type"`
+	}{
+		DatePickerElement: *d,
+		Type:              d.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose a time from a rich dropdown UI.
+type TimePickerElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial time that is selected when the element is loaded.
+	// This should be in the format HH:mm, where HH is the 24-hour format of an hour (00 to 23)
+	// and mm is minutes with leading zeros (00 to 59).
+	InitialTime string `json:"initial_time,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+	// A string in the IANA format, (e.g. "Asia/Seoul").
+	// The timezone is displayed to end users as hint text underneath the time picker.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+func (t *TimePickerElement) ElementType() ElementType {
+	return ElementTypeTimePicker
+}
+
+func (t *TimePickerElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		TimePickerElement
+		Type ElementType `json:"type"`
+	}{
+		TimePickerElement: *t,
+		Type:              t.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to select both a date and a time of day,
+// formatted as a Unix timestamp.
+type DateTimePickerElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// (unix-timestamp seconds)
+	// The initial date and time that is selected when the element is loaded.
+	InitialDateTime int64 `json:"initial_date_time,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+}
+
+func (d *DateTimePickerElement) ElementType() ElementType {
+	return ElementTypeDateTimePicker
+}
+
+func (d *DateTimePickerElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		DateTimePickerElement
+		Type ElementType `json:"type"`
+	}{
+		DateTimePickerElement: *d,
+		Type:                  d.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to enter freeform text data into a single-line or multi-line field.
+type PlainTextInputElement struct {
+	// An identifier for the input value when the parent modal is submitted.
+	// You can use this when you receive a view_submission payload to identify the value of the input element.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial value in the plain-text input when it is loaded.
+	InitialValue string `json:"initial_value,omitempty"`
+	// Indicates whether the input will be a single line (false) or a larger textarea (true).
+	// Defaults to false.
+	Multiline bool `json:"multiline,omitempty"`
+	// The minimum length of input that the user must provide.
+	// If the user provides less, they will receive an error. Maximum value is 3000.
+	MinLength int `json:"min_length,omitempty"`
+	// The maximum length of input that the user can provide.
+	// If the user provides more, they will receive an error.
+	MaxLength int `json:"max_length,omitempty"`
+	// A dispatch configuration object that determines when during text input
+	// the element returns a block_actions payload.
+	DispatchActionConfig *DispatchActionConfigObject `json:"dispatch_action_config,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown in the input.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (p *PlainTextInputElement) ElementType() ElementType {
+	return ElementTypePlainTextInput
+}
+
+func (p *PlainTextInputElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		PlainTextInputElement
+		Type ElementType `json:"type"`
+	}{
+		PlainTextInputElement: *p,
+		Type:                  p.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows user to enter an email into a single-line field.
+type EmailTextInputElement struct {
+	// An identifier for the input value when the parent modal is submitted.
+	// You can use this when you receive a view_submission payload to identify the value of the input element.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial value in the email input when it is loaded.
+	InitialValue string `json:"initial_value,omitempty"`
+	// A dispatch configuration object that determines when during text input
+	// the element returns a block_actions payload.
+	DispatchActionConfig *DispatchActionConfigObject `json:"dispatch_action_config,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown in the input.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (e *EmailTextInputElement) ElementType() ElementType {
+	return ElementTypeEmailTextInput
+}
+
+func (e *EmailTextInputElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		EmailTextInputElement
+		Type ElementType `json:"type"`
+	}{
+		EmailTextInputElement: *e,
+		Type:                  e.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows user to enter a URL into a single-line field.
+type URLTextInputElement struct {
+	// An identifier for the input value when the parent modal is submitted.
+	// You can use this when you receive a view_submission payload to identify the value of the input element.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial value in the URL input when it is loaded.
+	InitialValue string `json:"initial_value,omitempty"`
+	// A dispatch configuration object that determines when during text input
+	// the element returns a block_actions payload.
+	DispatchActionConfig *DispatchActionConfigObject `json:"dispatch_action_config,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown in the input.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (u *URLTextInputElement) ElementType() ElementType {
+	return ElementTypeURLTextInput
+}
+
+func (u *URLTextInputElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		URLTextInputElement
+		Type ElementType `json:"type"`
+	}{
+		URLTextInputElement: *u,
+		Type:                u.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows user to enter a number into a single-line field.
+// The number input element accepts both whole and decimal numbers.
+type NumberInputElement struct {
+	// Decimal numbers are allowed if is_decimal_allowed = true, set the value to false otherwise.
+	IsDecimalAllowed bool `json:"is_decimal_allowed"`
+	// An identifier for the input value when the parent modal is submitted.
+	// You can use this when you receive a view_submission payload to identify the value of the input element.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial value in the number input when it is loaded.
+	InitialValue string `json:"initial_value,omitempty"`
+	// The minimum value, cannot be greater than max_value.
+	MinValue string `json:"min_value,omitempty"`
+	// The maximum value, cannot be less than min_value.
+	MaxValue string `json:"max_value,omitempty"`
+	// A dispatch configuration object that determines when during text input
+	// the element returns a block_actions payload.
+	DispatchActionConfig *DispatchActionConfigObject `json:"dispatch_action_config,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown in the input.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (n *NumberInputElement) ElementType() ElementType {
+	return ElementTypeNumberInput
+}
+
+func (n *NumberInputElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		NumberInputElement
+		Type ElementType `json:"type"`
+	}{
+		NumberInputElement: *n,
+		Type:               n.ElementType(),
+	}
+	return json.Marshal(raw)
+}
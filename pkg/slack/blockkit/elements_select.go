@@ -0,0 +1,376 @@
+package blockkit
+
+import (
+	"encoding/json"
+)
+
+// Reference
+// https://api.slack.com/reference/block-kit/block-elements#select
+// https://api.slack.com/reference/block-kit/block-elements#multi_select
+
+// Allows users to choose an option from a drop down menu, loaded from an external data source.
+type ExternalSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// A single option that exactly matches one of the options within the options or option_groups
+	// loaded from the external data source. This option will be selected when the menu initially loads.
+	InitialOption *OptionBlockObject `json:"initial_option,omitempty"`
+	// When the typeahead field is used, a request will be sent on every character change.
+	// If you prefer fewer requests or more fully ideated queries,
+	// use the min_query_length attribute to tell Slack the fewest number of typed characters required
+	// before dispatch. The default value is 3.
+	MinQueryLength int `json:"min_query_length,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (e *ExternalSelectElement) ElementType() ElementType {
+	return ElementTypeExternalSelect
+}
+
+func (e *ExternalSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ExternalSelectElement
+		Type ElementType `json:"type"`
+	}{
+		ExternalSelectElement: *e,
+		Type:                  e.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose a user from a drop down menu, populated with the users in the workspace.
+type UsersSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The user ID of any valid user to be pre-selected when the menu loads.
+	InitialUser string `json:"initial_user,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (u *UsersSelectElement) ElementType() ElementType {
+	return ElementTypeUsersSelect
+}
+
+func (u *UsersSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		UsersSelectElement
+		Type ElementType `json:"type"`
+	}{
+		UsersSelectElement: *u,
+		Type:               u.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose a conversation from a drop down menu,
+// populated with public and private channels, DMs, and MPIMs visible to the current user.
+type ConversationsSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The ID of any valid conversation to be pre-selected when the menu loads.
+	// If default_to_current_conversation is also supplied, initial_conversation will take precedence.
+	InitialConversation string `json:"initial_conversation,omitempty"`
+	// Pre-populates the select menu with the conversation that the user was viewing
+	// when they opened the modal, if available. Default is false.
+	DefaultToCurrentConversation bool `json:"default_to_current_conversation,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// When set to true, the view_submission payload from the menu's parent view will contain a response_url.
+	// This response_url can be used for message responses.
+	// The target conversation for the message will be determined by the value of this select menu.
+	ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+	// A filter object that reduces the list of available conversations using the specified criteria.
+	Filter *ConversationFilterObject `json:"filter,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (c *ConversationsSelectElement) ElementType() ElementType {
+	return ElementTypeConversationsSelect
+}
+
+func (c *ConversationsSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ConversationsSelectElement
+		Type ElementType `json:"type"`
+	}{
+		ConversationsSelectElement: *c,
+		Type:                       c.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose a public channel from a drop down menu,
+// populated with the public channels in the workspace.
+type ChannelsSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The ID of any valid public channel to be pre-selected when the menu loads.
+	InitialChannel string `json:"initial_channel,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// When set to true, the view_submission payload from the menu's parent view will contain a response_url.
+	// This response_url can be used for message responses.
+	// The target conversation for the message will be determined by the value of this select menu.
+	ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (c *ChannelsSelectElement) ElementType() ElementType {
+	return ElementTypeChannelsSelect
+}
+
+func (c *ChannelsSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ChannelsSelectElement
+		Type ElementType `json:"type"`
+	}{
+		ChannelsSelectElement: *c,
+		Type:                  c.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose multiple items from a list of options.
+type MultiStaticSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. Maximum number of options is 100.
+	// If option_groups is specified, this field should not be.
+	Options []OptionBlockObject `json:"options,omitempty"`
+	// An array of option group objects. Maximum number of option groups is 100.
+	// If options is specified, this field should not be.
+	OptionGroups []OptionGroupObject `json:"option_groups,omitempty"`
+	// An array of option objects that exactly match one or more of the options within options or option_groups.
+	// These options will be selected when the menu initially loads.
+	InitialOptions []OptionBlockObject `json:"initial_options,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Specifies the maximum number of items that can be selected in the menu. Minimum number is 1.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (m *MultiStaticSelectElement) ElementType() ElementType {
+	return ElementTypeMultiStaticSelect
+}
+
+func (m *MultiStaticSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		MultiStaticSelectElement
+		Type ElementType `json:"type"`
+	}{
+		MultiStaticSelectElement: *m,
+		Type:                     m.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose multiple items from a list of options, loaded from an external data source.
+type MultiExternalSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// When the typeahead field is used, a request will be sent on every character change.
+	// If you prefer fewer requests or more fully ideated queries,
+	// use the min_query_length attribute to tell Slack the fewest number of typed characters required
+	// before dispatch. The default value is 3.
+	MinQueryLength int `json:"min_query_length,omitempty"`
+	// An array of option objects that exactly match one or more of the options
+	// loaded from the external data source. These options will be selected when the menu initially loads.
+	InitialOptions []OptionBlockObject `json:"initial_options,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Specifies the maximum number of items that can be selected in the menu. Minimum number is 1.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (m *MultiExternalSelectElement) ElementType() ElementType {
+	return ElementTypeMultiExternalSelect
+}
+
+func (m *MultiExternalSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		MultiExternalSelectElement
+		Type ElementType `json:"type"`
+	}{
+		MultiExternalSelectElement: *m,
+		Type:                       m.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose multiple users from a list of users in the workspace.
+type MultiUsersSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of user IDs of any valid users to be pre-selected when the menu loads.
+	InitialUsers []string `json:"initial_users,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Specifies the maximum number of items that can be selected in the menu. Minimum number is 1.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (m *MultiUsersSelectElement) ElementType() ElementType {
+	return ElementTypeMultiUsersSelect
+}
+
+func (m *MultiUsersSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		MultiUsersSelectElement
+		Type ElementType `json:"type"`
+	}{
+		MultiUsersSelectElement: *m,
+		Type:                    m.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose multiple conversations from a list of public and private channels,
+// DMs, and MPIMs visible to the current user.
+type MultiConversationsSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of one or more IDs of any valid conversations to be pre-selected when the menu loads.
+	// If default_to_current_conversation is also supplied, initial_conversations will be ignored.
+	InitialConversations []string `json:"initial_conversations,omitempty"`
+	// Pre-populates the select menu with the conversation that the user was viewing
+	// when they opened the modal, if available. Default is false.
+	DefaultToCurrentConversation bool `json:"default_to_current_conversation,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Specifies the maximum number of items that can be selected in the menu. Minimum number is 1.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+	// A filter object that reduces the list of available conversations using the specified criteria.
+	Filter *ConversationFilterObject `json:"filter,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (m *MultiConversationsSelectElement) ElementType() ElementType {
+	return ElementTypeMultiConversationsSelect
+}
+
+func (m *MultiConversationsSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		MultiConversationsSelectElement
+		Type ElementType `json:"type"`
+	}{
+		MultiConversationsSelectElement: *m,
+		Type:                            m.ElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// Allows users to choose multiple public channels from a list of public channels in the workspace.
+type MultiChannelsSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// You can use this when you receive an interaction payload to identify the source of the action.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of one or more IDs of any valid public channel to be pre-selected when the menu loads.
+	InitialChannels []string `json:"initial_channels,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after
+	// a menu item is selected.
+	Confirm *ConfirmDialogObject `json:"confirm,omitempty"`
+	// Specifies the maximum number of items that can be selected in the menu. Minimum number is 1.
+	MaxSelectedItems int `json:"max_selected_items,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A `plain_text` only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+func (m *MultiChannelsSelectElement) ElementType() ElementType {
+	return ElementTypeMultiChannelsSelect
+}
+
+func (m *MultiChannelsSelectElement) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		MultiChannelsSelectElement
+		Type ElementType `json:"type"`
+	}{
+		MultiChannelsSelectElement: *m,
+		Type:                       m.ElementType(),
+	}
+	return json.Marshal(raw)
+}
@@ -31,6 +31,57 @@ type TextObject struct {
 	Verbatim bool `json:"verbatim,omitempty"`
 }
 
+// Defines a single item in a number of item selection elements.
+type OptionBlockObject struct {
+	// A text object that defines the text shown in the option on the menu.
+	// Overflow, select, and multi-select menus can only use `plain_text` objects,
+	// while radio buttons and checkboxes can use `mrkdwn` text objects.
+	// Maximum length for the text in this field is 75 characters.
+	Text TextObject `json:"text"`
+	// A unique string value that will be passed to your app when this option is chosen.
+	// Maximum length for this field is 150 characters.
+	Value string `json:"value"`
+	// A `plain_text` text object that defines a line of descriptive text shown below the text field
+	// beside a single selectable item in a select menu, multi-select menu, checkbox group,
+	// radio button group, or overflow menu.
+	// Maximum length for the text within this field is 75 characters.
+	Description *TextObject `json:"description,omitempty"`
+	// A URL to load in the user's browser when the option is clicked.
+	// The url attribute is only available in overflow menus.
+	// Maximum length for this field is 3000 characters.
+	URL string `json:"url,omitempty"`
+}
+
+// Provides a way to group options in a select menu or multi-select menu.
+type OptionGroupObject struct {
+	// A `plain_text` text object that defines the label shown above this group of options.
+	// Maximum length for the text in this field is 75 characters.
+	Label TextObject `json:"label"`
+	// An array of option objects that belong to this specific group. Maximum of 100 items.
+	Options []OptionBlockObject `json:"options"`
+}
+
+// Determines when a plain-text input element will return a block_actions interaction payload.
+type DispatchActionConfigObject struct {
+	// An array of interaction types that you would like to receive a block_actions payload for.
+	// Should be one or both of `on_enter_pressed` or `on_character_entered`.
+	TriggerActionsOn []string `json:"trigger_actions_on,omitempty"`
+}
+
+// Provides a way to filter the list of options in a conversations select menu
+// or conversations multi-select menu.
+type ConversationFilterObject struct {
+	// Indicates which type of conversations should be included in the list.
+	// When this field is provided, any conversations that do not match will be excluded.
+	// You should provide an array of strings from the following options: im, mpim, private, and public.
+	Include []string `json:"include,omitempty"`
+	// Indicates whether to exclude external shared channels from conversation lists.
+	// This field will not exclude users from shared channels. Defaults to false.
+	ExcludeExternalSharedChannels bool `json:"exclude_external_shared_channels,omitempty"`
+	// Indicates whether to exclude bot users from conversation lists. Defaults to false.
+	ExcludeBotUsers bool `json:"exclude_bot_users,omitempty"`
+}
+
 // Defines a dialog that adds a confirmation step to interactive elements.
 type ConfirmDialogObject struct {
 	// A `plain_text` text object that defines the dialog's title.
@@ -48,3 +99,12 @@ type ConfirmDialogObject struct {
 	// Defines the color scheme applied to the confirm button.
 	Style ButtonStyle `json:"style,omitempty"`
 }
+
+// Defines an object containing Slack file information to be used in an image block or image element.
+// Either url or id is required.
+type SlackFileObject struct {
+	// This URL can be the url_private or the permalink of the Slack file.
+	URL string `json:"url,omitempty"`
+	// Slack ID of the file.
+	ID string `json:"id,omitempty"`
+}
@@ -0,0 +1,172 @@
+package blockkit
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// 메시지의 text 필드에 권장되는 최대 길이.
+const MaxFallbackTextLength = 4000
+
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// 블록 목록을 알림이나 스크린 리더에 표시할 mrkdwn 텍스트로 변환한다.
+// 메시지의 text 필드가 비어있을 때 대체 텍스트로 사용한다.
+func RenderText(blocks []SlackBlock) string {
+	lines := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if line := renderBlock(block); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	text := strings.Join(lines, "\n")
+	if utf8.RuneCountInString(text) > MaxFallbackTextLength {
+		runes := []rune(text)
+		text = string(runes[:MaxFallbackTextLength-1]) + "…"
+	}
+	return text
+}
+
+func renderBlock(block SlackBlock) string {
+	switch b := block.(type) {
+	case *HeaderBlock:
+		if text := renderTextObject(&b.Text); text != "" {
+			return "*" + text + "*"
+		}
+	case *SectionBlock:
+		parts := make([]string, 0, len(b.Fields)+2)
+		if b.Text != nil {
+			parts = append(parts, renderTextObject(b.Text))
+		}
+		for i := range b.Fields {
+			parts = append(parts, renderTextObject(&b.Fields[i]))
+		}
+		if b.Accessory != nil {
+			parts = append(parts, renderElement(b.Accessory))
+		}
+		return joinNonEmpty(parts, "\n")
+	case *ActionBlock:
+		parts := make([]string, 0, len(b.Elements))
+		for _, element := range b.Elements {
+			parts = append(parts, renderElement(element))
+		}
+		return joinNonEmpty(parts, " ")
+	case *ContextBlock:
+		parts := make([]string, 0, len(b.Elements))
+		for _, element := range b.Elements {
+			switch e := element.(type) {
+			case *TextObject:
+				parts = append(parts, renderTextObject(e))
+			case *ImageElement:
+				parts = append(parts, renderElement(e))
+			}
+		}
+		return joinNonEmpty(parts, " ")
+	case *ImageBlock:
+		if b.Title != nil {
+			return fmt.Sprintf("[이미지: %s]", renderTextObject(b.Title))
+		}
+		if b.AltText != "" {
+			return fmt.Sprintf("[이미지: %s]", mrkdwnEscaper.Replace(b.AltText))
+		}
+	case *VideoBlock:
+		if text := renderTextObject(&b.Title); text != "" {
+			return fmt.Sprintf("[동영상: %s]", text)
+		}
+	case *InputBlock:
+		return renderTextObject(&b.Label)
+	case *RichTextBlock:
+		parts := make([]string, 0, len(b.Elements))
+		for _, element := range b.Elements {
+			parts = append(parts, renderRichTextElement(element))
+		}
+		return joinNonEmpty(parts, "\n")
+	}
+	return ""
+}
+
+func renderElement(element SlackBlockElement) string {
+	switch e := element.(type) {
+	case *ButtonElement:
+		if text := renderTextObject(&e.Text); text != "" {
+			return "[" + text + "]"
+		}
+	case *ImageElement:
+		if e.AltText != "" {
+			return fmt.Sprintf("[이미지: %s]", mrkdwnEscaper.Replace(e.AltText))
+		}
+	}
+	return ""
+}
+
+func renderTextObject(t *TextObject) string {
+	if t.Type == TextTypePlainText {
+		return mrkdwnEscaper.Replace(t.Text)
+	}
+	return t.Text
+}
+
+func renderRichTextElement(element RichTextElement) string {
+	switch e := element.(type) {
+	case *RichTextSection:
+		return renderRichTextSectionElements(e.Elements)
+	case *RichTextList:
+		lines := make([]string, 0, len(e.Elements))
+		for i, section := range e.Elements {
+			prefix := "• "
+			if e.Style == RichTextListStyleOrdered {
+				prefix = fmt.Sprintf("%d. ", e.Offset+i+1)
+			}
+			lines = append(lines, prefix+renderRichTextSectionElements(section.Elements))
+		}
+		return strings.Join(lines, "\n")
+	case *RichTextQuote:
+		return "> " + renderRichTextSectionElements(e.Elements)
+	case *RichTextPreformatted:
+		return "```" + renderRichTextSectionElements(e.Elements) + "```"
+	}
+	return ""
+}
+
+func renderRichTextSectionElements(elements []RichTextSectionElement) string {
+	var builder strings.Builder
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *RichTextText:
+			builder.WriteString(mrkdwnEscaper.Replace(e.Text))
+		case *RichTextLink:
+			if e.Text != "" {
+				builder.WriteString(fmt.Sprintf("<%s|%s>", e.URL, mrkdwnEscaper.Replace(e.Text)))
+			} else {
+				builder.WriteString(fmt.Sprintf("<%s>", e.URL))
+			}
+		case *RichTextEmoji:
+			builder.WriteString(":" + e.Name + ":")
+		case *RichTextUser:
+			builder.WriteString("<@" + e.UserID + ">")
+		case *RichTextChannel:
+			builder.WriteString("<#" + e.ChannelID + ">")
+		case *RichTextUsergroup:
+			builder.WriteString("<!subteam^" + e.UsergroupID + ">")
+		case *RichTextBroadcast:
+			builder.WriteString("<!" + e.Range + ">")
+		case *RichTextDate:
+			builder.WriteString(fmt.Sprintf("<!date^%d^%s|%s>", e.Timestamp, e.Format, e.Fallback))
+		case *RichTextColor:
+			builder.WriteString(e.Value)
+		}
+	}
+	return builder.String()
+}
+
+func joinNonEmpty(parts []string, sep string) string {
+	values := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			values = append(values, part)
+		}
+	}
+	return strings.Join(values, sep)
+}
@@ -0,0 +1,110 @@
+package blockkit_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+func TestRenderText(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		blocks []blockkit.SlackBlock
+		want   string
+	}{
+		{
+			desc: "empty",
+			want: "",
+		},
+		{
+			desc: "header, section and context",
+			blocks: []blockkit.SlackBlock{
+				blockkit.NewHeaderBlock("🌤️ 서울 날씨"),
+				&blockkit.DividerBlock{},
+				&blockkit.SectionBlock{
+					Text: &blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: "*기온* 21℃"},
+					Fields: []blockkit.TextObject{
+						{Type: blockkit.TextTypeMarkdown, Text: "*습도* 40%"},
+						{Type: blockkit.TextTypeMarkdown, Text: "*강수* 없음"},
+					},
+				},
+				blockkit.NewContextBlock("📡 출처: 기상청", "09:00 기준"),
+			},
+			want: "*🌤️ 서울 날씨*\n*기온* 21℃\n*습도* 40%\n*강수* 없음\n📡 출처: 기상청 09:00 기준",
+		},
+		{
+			desc: "button labels",
+			blocks: []blockkit.SlackBlock{
+				&blockkit.SectionBlock{
+					Text:      &blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: "공휴일 안내"},
+					Accessory: &blockkit.ButtonElement{Text: plainText("보기")},
+				},
+				&blockkit.ActionBlock{
+					Elements: []blockkit.SlackBlockElement{
+						&blockkit.ButtonElement{Text: plainText("📋 지원 기능")},
+						&blockkit.ButtonElement{Text: plainText("✅ 완료")},
+						&blockkit.SelectElement{Placeholder: plainText("지역")},
+					},
+				},
+			},
+			want: "공휴일 안내\n[보기]\n[📋 지원 기능] [✅ 완료]",
+		},
+		{
+			desc: "plain text is escaped",
+			blocks: []blockkit.SlackBlock{
+				blockkit.NewHeaderBlock("A & B <C>"),
+			},
+			want: "*A &amp; B &lt;C&gt;*",
+		},
+		{
+			desc: "rich text",
+			blocks: []blockkit.SlackBlock{
+				&blockkit.RichTextBlock{
+					Elements: []blockkit.RichTextElement{
+						&blockkit.RichTextSection{
+							Elements: []blockkit.RichTextSectionElement{
+								&blockkit.RichTextText{Text: "안녕하세요 "},
+								&blockkit.RichTextUser{UserID: "U123"},
+							},
+						},
+						&blockkit.RichTextList{
+							Style: blockkit.RichTextListStyleOrdered,
+							Elements: []*blockkit.RichTextSection{
+								{Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "첫째"}}},
+								{Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "둘째"}}},
+							},
+						},
+					},
+				},
+			},
+			want: "안녕하세요 <@U123>\n1. 첫째\n2. 둘째",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := blockkit.RenderText(tc.blocks); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderTextTruncate(t *testing.T) {
+	blocks := make([]blockkit.SlackBlock, 0, 3)
+	for range 3 {
+		blocks = append(blocks, &blockkit.SectionBlock{
+			Text: &blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: strings.Repeat("가", blockkit.MaxTextLength)},
+		})
+	}
+
+	got := blockkit.RenderText(blocks)
+	if n := utf8.RuneCountInString(got); n != blockkit.MaxFallbackTextLength {
+		t.Errorf("expected %d runes, got %d", blockkit.MaxFallbackTextLength, n)
+	}
+	if !strings.HasSuffix(got, "…") {
+		t.Errorf("expected ellipsis suffix")
+	}
+}
@@ -0,0 +1,537 @@
+package blockkit
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Reference
+// https://api.slack.com/reference/block-kit/blocks#rich_text
+
+type RichTextElementType string
+
+const (
+	RichTextElementTypeSection      RichTextElementType = "rich_text_section"
+	RichTextElementTypeList         RichTextElementType = "rich_text_list"
+	RichTextElementTypeQuote        RichTextElementType = "rich_text_quote"
+	RichTextElementTypePreformatted RichTextElementType = "rich_text_preformatted"
+)
+
+// Displays formatted, structured representation of text.
+type RichTextBlock struct {
+	// A unique identifier for a block. If not specified, one will be generated.
+	// Maximum length for this field is 255 characters.
+	BlockID string `json:"block_id,omitempty"`
+	// An array of rich text objects.
+	// (rich_text_section, rich_text_list, rich_text_preformatted, rich_text_quote)
+	Elements []RichTextElement `json:"elements"`
+}
+
+func (r *RichTextBlock) BlockType() BlockType {
+	return BlockTypeRichText
+}
+
+func (r *RichTextBlock) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextBlock
+		Type BlockType `json:"type"`
+	}{
+		RichTextBlock: *r,
+		Type:          r.BlockType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (r *RichTextBlock) UnmarshalJSON(data []byte) error {
+	type block RichTextBlock
+	raw := struct {
+		*block
+		Elements []json.RawMessage `json:"elements"`
+	}{
+		block: (*block)(r),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Elements = make([]RichTextElement, 0, len(raw.Elements))
+	for _, data := range raw.Elements {
+		element, err := unmarshalRichTextElement(data)
+		if err != nil {
+			return err
+		}
+		r.Elements = append(r.Elements, element)
+	}
+	return nil
+}
+
+// Rich text 블록을 구성하는 요소.
+type RichTextElement interface {
+	RichTextElementType() RichTextElementType
+}
+
+// A section of rich text.
+type RichTextSection struct {
+	// An array of rich text elements.
+	Elements []RichTextSectionElement `json:"elements"`
+}
+
+func (r *RichTextSection) RichTextElementType() RichTextElementType {
+	return RichTextElementTypeSection
+}
+
+func (r *RichTextSection) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextSection
+		Type RichTextElementType `json:"type"`
+	}{
+		RichTextSection: *r,
+		Type:            r.RichTextElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (r *RichTextSection) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalRichTextSectionElements(data)
+	if err != nil {
+		return err
+	}
+	r.Elements = elements
+	return nil
+}
+
+type RichTextListStyle string
+
+const (
+	RichTextListStyleBullet  RichTextListStyle = "bullet"
+	RichTextListStyleOrdered RichTextListStyle = "ordered"
+)
+
+// A list of rich text sections.
+type RichTextList struct {
+	// Either `bullet` or `ordered`, the latter meaning a numbered list.
+	Style RichTextListStyle `json:"style"`
+	// An array of rich_text_section objects containing two properties: type, which is "rich_text_section",
+	// and elements, which is an array of rich text element objects.
+	Elements []*RichTextSection `json:"elements"`
+	// Number of pixels to indent the list.
+	Indent int `json:"indent,omitempty"`
+	// Number to offset the first number in the list.
+	// For example, if the offset = 4, the first number in the ordered list would be 5.
+	Offset int `json:"offset,omitempty"`
+	// Number of pixels of border thickness.
+	Border int `json:"border,omitempty"`
+}
+
+func (r *RichTextList) RichTextElementType() RichTextElementType {
+	return RichTextElementTypeList
+}
+
+func (r *RichTextList) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextList
+		Type RichTextElementType `json:"type"`
+	}{
+		RichTextList: *r,
+		Type:         r.RichTextElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A quote of rich text.
+type RichTextQuote struct {
+	// An array of rich text elements.
+	Elements []RichTextSectionElement `json:"elements"`
+	// Number of pixels of border thickness.
+	Border int `json:"border,omitempty"`
+}
+
+func (r *RichTextQuote) RichTextElementType() RichTextElementType {
+	return RichTextElementTypeQuote
+}
+
+func (r *RichTextQuote) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextQuote
+		Type RichTextElementType `json:"type"`
+	}{
+		RichTextQuote: *r,
+		Type:          r.RichTextElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (r *RichTextQuote) UnmarshalJSON(data []byte) error {
+	var raw = struct {
+		Border int `json:"border"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalRichTextSectionElements(data)
+	if err != nil {
+		return err
+	}
+	r.Elements = elements
+	r.Border = raw.Border
+	return nil
+}
+
+// A block of preformatted code.
+type RichTextPreformatted struct {
+	// An array of rich text elements.
+	Elements []RichTextSectionElement `json:"elements"`
+	// Number of pixels of border thickness.
+	Border int `json:"border,omitempty"`
+}
+
+func (r *RichTextPreformatted) RichTextElementType() RichTextElementType {
+	return RichTextElementTypePreformatted
+}
+
+func (r *RichTextPreformatted) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextPreformatted
+		Type RichTextElementType `json:"type"`
+	}{
+		RichTextPreformatted: *r,
+		Type:                 r.RichTextElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+func (r *RichTextPreformatted) UnmarshalJSON(data []byte) error {
+	var raw = struct {
+		Border int `json:"border"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalRichTextSectionElements(data)
+	if err != nil {
+		return err
+	}
+	r.Elements = elements
+	r.Border = raw.Border
+	return nil
+}
+
+func unmarshalRichTextElement(data []byte) (RichTextElement, error) {
+	var raw = struct {
+		Type RichTextElementType `json:"type"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var element RichTextElement
+	switch raw.Type {
+	case RichTextElementTypeSection:
+		element = &RichTextSection{}
+	case RichTextElementTypeList:
+		element = &RichTextList{}
+	case RichTextElementTypeQuote:
+		element = &RichTextQuote{}
+	case RichTextElementTypePreformatted:
+		element = &RichTextPreformatted{}
+	default:
+		return nil, errors.New("undefined rich text element type: " + string(raw.Type))
+	}
+
+	if err := json.Unmarshal(data, element); err != nil {
+		return nil, err
+	}
+	return element, nil
+}
+
+type RichTextSectionElementType string
+
+const (
+	RichTextSectionElementTypeText      RichTextSectionElementType = "text"
+	RichTextSectionElementTypeLink      RichTextSectionElementType = "link"
+	RichTextSectionElementTypeEmoji     RichTextSectionElementType = "emoji"
+	RichTextSectionElementTypeUser      RichTextSectionElementType = "user"
+	RichTextSectionElementTypeChannel   RichTextSectionElementType = "channel"
+	RichTextSectionElementTypeUsergroup RichTextSectionElementType = "usergroup"
+	RichTextSectionElementTypeDate      RichTextSectionElementType = "date"
+	RichTextSectionElementTypeBroadcast RichTextSectionElementType = "broadcast"
+	RichTextSectionElementTypeColor     RichTextSectionElementType = "color"
+)
+
+// Rich text 섹션, 인용, 코드 블록에 포함되는 요소.
+type RichTextSectionElement interface {
+	RichTextSectionElementType() RichTextSectionElementType
+}
+
+// Rich text 요소에 적용할 서식.
+type RichTextStyle struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Strike bool `json:"strike,omitempty"`
+	Code   bool `json:"code,omitempty"`
+}
+
+// Plain text with an optional style.
+type RichTextText struct {
+	// The text shown to the user.
+	Text string `json:"text"`
+	// An object containing four boolean fields, none of which are required: bold, italic, strike, and code.
+	Style *RichTextStyle `json:"style,omitempty"`
+}
+
+func (r *RichTextText) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeText
+}
+
+func (r *RichTextText) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextText
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextText: *r,
+		Type:         r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A hyperlink.
+type RichTextLink struct {
+	// The link's url.
+	URL string `json:"url"`
+	// The text shown to the user (instead of the url). If no text is provided, the url is used.
+	Text string `json:"text,omitempty"`
+	// Indicates whether the link is safe.
+	Unsafe bool `json:"unsafe,omitempty"`
+	// An object containing four boolean properties: bold, italic, strike, and code.
+	Style *RichTextStyle `json:"style,omitempty"`
+}
+
+func (r *RichTextLink) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeLink
+}
+
+func (r *RichTextLink) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextLink
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextLink: *r,
+		Type:         r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// An emoji.
+type RichTextEmoji struct {
+	// The name of the emoji. (e.g. `wave`)
+	Name string `json:"name"`
+	// Represents the unicode code point of the emoji, where applicable.
+	Unicode string `json:"unicode,omitempty"`
+}
+
+func (r *RichTextEmoji) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeEmoji
+}
+
+func (r *RichTextEmoji) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextEmoji
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextEmoji: *r,
+		Type:          r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A user mention.
+type RichTextUser struct {
+	// The ID of the user to be mentioned.
+	UserID string `json:"user_id"`
+	// An object of optional boolean properties that dictate style.
+	Style *RichTextStyle `json:"style,omitempty"`
+}
+
+func (r *RichTextUser) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeUser
+}
+
+func (r *RichTextUser) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextUser
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextUser: *r,
+		Type:         r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A channel mention.
+type RichTextChannel struct {
+	// The ID of the channel to be mentioned.
+	ChannelID string `json:"channel_id"`
+	// An object of optional boolean properties that dictate style.
+	Style *RichTextStyle `json:"style,omitempty"`
+}
+
+func (r *RichTextChannel) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeChannel
+}
+
+func (r *RichTextChannel) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextChannel
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextChannel: *r,
+		Type:            r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A user group mention.
+type RichTextUsergroup struct {
+	// The ID of the user group to be mentioned.
+	UsergroupID string `json:"usergroup_id"`
+	// An object of optional boolean properties that dictate style.
+	Style *RichTextStyle `json:"style,omitempty"`
+}
+
+func (r *RichTextUsergroup) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeUsergroup
+}
+
+func (r *RichTextUsergroup) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextUsergroup
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextUsergroup: *r,
+		Type:              r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A date, displayed in the user's timezone.
+type RichTextDate struct {
+	// (unix-timestamp seconds)
+	// A Unix timestamp for the date to be displayed in seconds.
+	Timestamp int64 `json:"timestamp"`
+	// A template string containing curly-brace-enclosed tokens to substitute your provided timestamp.
+	// (e.g. `{date_long_full}`, `{time}`)
+	Format string `json:"format"`
+	// URL to link the entire format string to.
+	URL string `json:"url,omitempty"`
+	// Text to display in place of the date should parsing, formatting or displaying fail.
+	Fallback string `json:"fallback,omitempty"`
+}
+
+func (r *RichTextDate) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeDate
+}
+
+func (r *RichTextDate) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextDate
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextDate: *r,
+		Type:         r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A broadcast mention.
+type RichTextBroadcast struct {
+	// The range of the broadcast. One of `here`, `channel`, `everyone`.
+	Range string `json:"range"`
+}
+
+func (r *RichTextBroadcast) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeBroadcast
+}
+
+func (r *RichTextBroadcast) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextBroadcast
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextBroadcast: *r,
+		Type:              r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// A hex color.
+type RichTextColor struct {
+	// The hex value for the color. (e.g. `#F405B3`)
+	Value string `json:"value"`
+}
+
+func (r *RichTextColor) RichTextSectionElementType() RichTextSectionElementType {
+	return RichTextSectionElementTypeColor
+}
+
+func (r *RichTextColor) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		RichTextColor
+		Type RichTextSectionElementType `json:"type"`
+	}{
+		RichTextColor: *r,
+		Type:          r.RichTextSectionElementType(),
+	}
+	return json.Marshal(raw)
+}
+
+// 섹션, 인용, 코드 블록의 elements 필드를 디코딩한다.
+func unmarshalRichTextSectionElements(data []byte) ([]RichTextSectionElement, error) {
+	var raw = struct {
+		Elements []json.RawMessage `json:"elements"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	elements := make([]RichTextSectionElement, 0, len(raw.Elements))
+	for _, data := range raw.Elements {
+		var element = struct {
+			Type RichTextSectionElementType `json:"type"`
+		}{}
+		if err := json.Unmarshal(data, &element); err != nil {
+			return nil, err
+		}
+
+		var value RichTextSectionElement
+		switch element.Type {
+		case RichTextSectionElementTypeText:
+			value = &RichTextText{}
+		case RichTextSectionElementTypeLink:
+			value = &RichTextLink{}
+		case RichTextSectionElementTypeEmoji:
+			value = &RichTextEmoji{}
+		case RichTextSectionElementTypeUser:
+			value = &RichTextUser{}
+		case RichTextSectionElementTypeChannel:
+			value = &RichTextChannel{}
+		case RichTextSectionElementTypeUsergroup:
+			value = &RichTextUsergroup{}
+		case RichTextSectionElementTypeDate:
+			value = &RichTextDate{}
+		case RichTextSectionElementTypeBroadcast:
+			value = &RichTextBroadcast{}
+		case RichTextSectionElementTypeColor:
+			value = &RichTextColor{}
+		default:
+			return nil, errors.New("undefined rich text section element type: " + string(element.Type))
+		}
+
+		if err := json.Unmarshal(data, value); err != nil {
+			return nil, err
+		}
+		elements = append(elements, value)
+	}
+	return elements, nil
+}
@@ -11,5 +11,5 @@ const (
 	// danger gives buttons a red outline and text,
 	// and should be used when the action is destructive.
 	// Use danger even more sparingly than primary.
-	ButtonStyleDanger = "Danger"
+	ButtonStyleDanger = "danger"
 )
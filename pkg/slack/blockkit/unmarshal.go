@@ -0,0 +1,153 @@
+package blockkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// 블록 타입별로 디코딩할 값을 생성한다.
+var blockFactories = map[BlockType]func() SlackBlock{
+	BlockTypeSection:  func() SlackBlock { return &SectionBlock{} },
+	BlockTypeHeader:   func() SlackBlock { return &HeaderBlock{} },
+	BlockTypeAction:   func() SlackBlock { return &ActionBlock{} },
+	BlockTypeDivider:  func() SlackBlock { return &DividerBlock{} },
+	BlockTypeContext:  func() SlackBlock { return &ContextBlock{} },
+	BlockTypeImage:    func() SlackBlock { return &ImageBlock{} },
+	BlockTypeInput:    func() SlackBlock { return &InputBlock{} },
+	BlockTypeRichText: func() SlackBlock { return &RichTextBlock{} },
+	BlockTypeVideo:    func() SlackBlock { return &VideoBlock{} },
+	BlockTypeFile:     func() SlackBlock { return &FileBlock{} },
+}
+
+// 요소 타입별로 디코딩할 값을 생성한다.
+var elementFactories = map[ElementType]func() SlackBlockElement{
+	ElementTypeImage:                    func() SlackBlockElement { return &ImageElement{} },
+	ElementTypeButton:                   func() SlackBlockElement { return &ButtonElement{} },
+	ElementTypeSelect:                   func() SlackBlockElement { return &SelectElement{} },
+	ElementTypeExternalSelect:           func() SlackBlockElement { return &ExternalSelectElement{} },
+	ElementTypeUsersSelect:              func() SlackBlockElement { return &UsersSelectElement{} },
+	ElementTypeConversationsSelect:      func() SlackBlockElement { return &ConversationsSelectElement{} },
+	ElementTypeChannelsSelect:           func() SlackBlockElement { return &ChannelsSelectElement{} },
+	ElementTypeMultiStaticSelect:        func() SlackBlockElement { return &MultiStaticSelectElement{} },
+	ElementTypeMultiExternalSelect:      func() SlackBlockElement { return &MultiExternalSelectElement{} },
+	ElementTypeMultiUsersSelect:         func() SlackBlockElement { return &MultiUsersSelectElement{} },
+	ElementTypeMultiConversationsSelect: func() SlackBlockElement { return &MultiConversationsSelectElement{} },
+	ElementTypeMultiChannelsSelect:      func() SlackBlockElement { return &MultiChannelsSelectElement{} },
+	ElementTypeCheckboxes:               func() SlackBlockElement { return &CheckboxesElement{} },
+	ElementTypeRadioButtons:             func() SlackBlockElement { return &RadioButtonsElement{} },
+	ElementTypeOverflow:                 func() SlackBlockElement { return &OverflowElement{} },
+	ElementTypeDatePicker:               func() SlackBlockElement { return &DatePickerElement{} },
+	ElementTypeTimePicker:               func() SlackBlockElement { return &TimePickerElement{} },
+	ElementTypeDateTimePicker:           func() SlackBlockElement { return &DateTimePickerElement{} },
+	ElementTypePlainTextInput:           func() SlackBlockElement { return &PlainTextInputElement{} },
+	ElementTypeEmailTextInput:           func() SlackBlockElement { return &EmailTextInputElement{} },
+	ElementTypeURLTextInput:             func() SlackBlockElement { return &URLTextInputElement{} },
+	ElementTypeNumberInput:              func() SlackBlockElement { return &NumberInputElement{} },
+}
+
+// 여러 블록을 담는 목록.
+// JSON 으로 디코딩할 때 각 블록의 type 필드에 따라 알맞은 블록 타입으로 변환한다.
+type Blocks []SlackBlock
+
+func (b *Blocks) UnmarshalJSON(data []byte) error {
+	blocks, err := UnmarshalBlocks(data)
+	if err != nil {
+		return err
+	}
+	*b = blocks
+	return nil
+}
+
+// JSON 배열을 블록 목록으로 디코딩한다.
+func UnmarshalBlocks(data []byte) ([]SlackBlock, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]SlackBlock, 0, len(raws))
+	for _, raw := range raws {
+		block, err := UnmarshalBlock(raw)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// JSON 객체를 type 필드에 해당하는 블록으로 디코딩한다.
+func UnmarshalBlock(data []byte) (SlackBlock, error) {
+	var raw = struct {
+		Type BlockType `json:"type"`
+	}{}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	factory, ok := blockFactories[raw.Type]
+	if !ok {
+		return nil, errors.New("undefined block type: " + string(raw.Type))
+	}
+
+	block := factory()
+	if err := json.Unmarshal(data, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// JSON 배열을 블록 요소 목록으로 디코딩한다.
+func UnmarshalElements(data []byte) ([]SlackBlockElement, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+
+	elements := make([]SlackBlockElement, 0, len(raws))
+	for _, raw := range raws {
+		element, err := UnmarshalElement(raw)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+// JSON 객체를 type 필드에 해당하는 블록 요소로 디코딩한다.
+func UnmarshalElement(data []byte) (SlackBlockElement, error) {
+	var raw = struct {
+		Type ElementType `json:"type"`
+	}{}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	factory, ok := elementFactories[raw.Type]
+	if !ok {
+		return nil, errors.New("undefined element type: " + string(raw.Type))
+	}
+
+	element := factory()
+	if err := json.Unmarshal(data, element); err != nil {
+		return nil, err
+	}
+	return element, nil
+}
+
+func isNull(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || bytes.Equal(data, []byte("null"))
+}
@@ -0,0 +1,311 @@
+package blockkit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+func TestUnmarshalBlocksRoundTrip(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		blocks blockkit.Blocks
+	}{
+		{
+			desc: "header and divider",
+			blocks: blockkit.Blocks{
+				blockkit.NewHeaderBlock("🗓️ 공휴일 안내"),
+				&blockkit.DividerBlock{},
+			},
+		},
+		{
+			desc: "section with fields and button accessory",
+			blocks: blockkit.Blocks{
+				&blockkit.SectionBlock{
+					BlockID: "holiday",
+					Text: &blockkit.TextObject{
+						Type: blockkit.TextTypeMarkdown,
+						Text: "*공휴일 안내*    `/자비스 공휴일`",
+					},
+					Fields: []blockkit.TextObject{
+						{Type: blockkit.TextTypeMarkdown, Text: "*1월*"},
+						{Type: blockkit.TextTypeMarkdown, Text: "*2월*"},
+					},
+					Accessory: &blockkit.ButtonElement{
+						ActionID: "holiday",
+						Text:     blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "실행"},
+						Style:    blockkit.ButtonStylePrimary,
+					},
+				},
+			},
+		},
+		{
+			desc: "section with image accessory",
+			blocks: blockkit.Blocks{
+				&blockkit.SectionBlock{
+					Text: &blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "날씨"},
+					Accessory: &blockkit.ImageElement{
+						AltText:  "sunny",
+						ImageURL: "https://example.com/sunny.png",
+					},
+				},
+			},
+		},
+		{
+			desc: "actions with button and select",
+			blocks: blockkit.Blocks{
+				&blockkit.ActionBlock{
+					BlockID: "actions",
+					Elements: []blockkit.SlackBlockElement{
+						&blockkit.ButtonElement{
+							ActionID: "done",
+							Text:     blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "✅ 완료", Emoji: true},
+						},
+						&blockkit.SelectElement{
+							ActionID:    "region",
+							Placeholder: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "지역"},
+							Options: []blockkit.OptionBlockObject{
+								{Text: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "서울"}, Value: "seoul"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "context with text and image",
+			blocks: blockkit.Blocks{
+				&blockkit.ContextBlock{
+					Elements: []blockkit.ContextElement{
+						&blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: "📡 출처: 기상청"},
+						&blockkit.ImageElement{AltText: "logo", ImageURL: "https://example.com/logo.png"},
+					},
+				},
+			},
+		},
+		{
+			desc: "image, video and file",
+			blocks: blockkit.Blocks{
+				&blockkit.ImageBlock{
+					AltText:  "map",
+					ImageURL: "https://example.com/map.png",
+					Title:    &blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "지도"},
+				},
+				&blockkit.VideoBlock{
+					AltText:      "guide",
+					Title:        blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "사용 가이드"},
+					ThumbnailURL: "https://example.com/thumb.png",
+					VideoURL:     "https://example.com/embed/guide",
+				},
+				&blockkit.FileBlock{ExternalID: "ABCD1", Source: "remote"},
+			},
+		},
+		{
+			desc: "input with select element",
+			blocks: blockkit.Blocks{
+				&blockkit.InputBlock{
+					BlockID: "kind_block",
+					Label:   blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "종류"},
+					Element: &blockkit.SelectElement{
+						ActionID:    "kind_select",
+						Placeholder: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "선택"},
+						Options: []blockkit.OptionBlockObject{
+							{Text: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "반차"}, Value: "half"},
+						},
+					},
+					Hint:     &blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "휴가 종류를 선택하세요."},
+					Optional: true,
+				},
+			},
+		},
+		{
+			desc: "rich text",
+			blocks: blockkit.Blocks{
+				&blockkit.RichTextBlock{
+					Elements: []blockkit.RichTextElement{
+						&blockkit.RichTextSection{
+							Elements: []blockkit.RichTextSectionElement{
+								&blockkit.RichTextText{Text: "안녕하세요 ", Style: &blockkit.RichTextStyle{Bold: true}},
+								&blockkit.RichTextUser{UserID: "U123ABC456"},
+								&blockkit.RichTextEmoji{Name: "wave"},
+								&blockkit.RichTextLink{URL: "https://example.com", Text: "링크"},
+								&blockkit.RichTextChannel{ChannelID: "C123ABC456"},
+								&blockkit.RichTextUsergroup{UsergroupID: "S123ABC456"},
+								&blockkit.RichTextDate{Timestamp: 1720710212, Format: "{date_num} at {time}"},
+								&blockkit.RichTextBroadcast{Range: "here"},
+								&blockkit.RichTextColor{Value: "#F405B3"},
+							},
+						},
+						&blockkit.RichTextList{
+							Style: blockkit.RichTextListStyleOrdered,
+							Elements: []*blockkit.RichTextSection{
+								{Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "첫번째"}}},
+								{Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "두번째"}}},
+							},
+							Indent: 1,
+						},
+						&blockkit.RichTextQuote{
+							Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "인용"}},
+							Border:   1,
+						},
+						&blockkit.RichTextPreformatted{
+							Elements: []blockkit.RichTextSectionElement{&blockkit.RichTextText{Text: "go test ./..."}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			want, err := json.Marshal(tc.blocks)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			var blocks blockkit.Blocks
+			if err := json.Unmarshal(want, &blocks); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if len(blocks) != len(tc.blocks) {
+				t.Fatalf("expected %d blocks, got %d", len(tc.blocks), len(blocks))
+			}
+			for i := range blocks {
+				if blocks[i].BlockType() != tc.blocks[i].BlockType() {
+					t.Errorf("expected %q, got %q", tc.blocks[i].BlockType(), blocks[i].BlockType())
+				}
+			}
+
+			got, err := json.Marshal(blocks)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			if !bytes.Equal(want, got) {
+				t.Errorf("round trip mismatch\nwant: %s\ngot:  %s", want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBlocksError(t *testing.T) {
+	testCases := []struct {
+		desc string
+		data string
+	}{
+		{
+			desc: "undefined block type",
+			data: `[{"type": "undefined"}]`,
+		},
+		{
+			desc: "missing block type",
+			data: `[{"block_id": "a"}]`,
+		},
+		{
+			desc: "undefined accessory type",
+			data: `[{"type": "section", "text": {"type": "mrkdwn", "text": "a"}, "accessory": {"type": "undefined"}}]`,
+		},
+		{
+			desc: "undefined context element type",
+			data: `[{"type": "context", "elements": [{"type": "button"}]}]`,
+		},
+		{
+			desc: "undefined rich text element type",
+			data: `[{"type": "rich_text", "elements": [{"type": "rich_text_undefined"}]}]`,
+		},
+		{
+			desc: "not an array",
+			data: `{"type": "divider"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := blockkit.UnmarshalBlocks([]byte(tc.data)); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestUnmarshalElementsRoundTrip(t *testing.T) {
+	placeholder := &blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "선택"}
+	options := []blockkit.OptionBlockObject{
+		{Text: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "서울"}, Value: "seoul"},
+		{Text: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "부산"}, Value: "busan"},
+	}
+	confirm := &blockkit.ConfirmDialogObject{
+		Title:   blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "확인"},
+		Text:    blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "진행할까요?"},
+		Confirm: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "네"},
+		Deny:    blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "아니요"},
+	}
+
+	elements := []blockkit.SlackBlockElement{
+		&blockkit.ButtonElement{ActionID: "done", Text: *placeholder, Confirm: confirm},
+		&blockkit.SelectElement{
+			ActionID:    "grouped",
+			Placeholder: *placeholder,
+			OptionGroups: []blockkit.OptionGroupObject{
+				{Label: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "수도권"}, Options: options[:1]},
+				{Label: blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: "영남"}, Options: options[1:]},
+			},
+			InitialOption: &options[0],
+		},
+		&blockkit.ExternalSelectElement{ActionID: "external", MinQueryLength: 2, Placeholder: placeholder},
+		&blockkit.UsersSelectElement{ActionID: "user", InitialUser: "U123ABC456"},
+		&blockkit.ConversationsSelectElement{
+			ActionID:                     "conversation",
+			DefaultToCurrentConversation: true,
+			Filter:                       &blockkit.ConversationFilterObject{Include: []string{"public", "private"}},
+		},
+		&blockkit.ChannelsSelectElement{ActionID: "channel", InitialChannel: "C123ABC456"},
+		&blockkit.MultiStaticSelectElement{ActionID: "multi_static", Options: options, InitialOptions: options[:1], MaxSelectedItems: 2},
+		&blockkit.MultiExternalSelectElement{ActionID: "multi_external"},
+		&blockkit.MultiUsersSelectElement{ActionID: "multi_user", InitialUsers: []string{"U1", "U2"}},
+		&blockkit.MultiConversationsSelectElement{ActionID: "multi_conversation", InitialConversations: []string{"C1"}},
+		&blockkit.MultiChannelsSelectElement{ActionID: "multi_channel", InitialChannels: []string{"C1", "C2"}},
+		&blockkit.CheckboxesElement{ActionID: "checkboxes", Options: options, InitialOptions: options[1:]},
+		&blockkit.RadioButtonsElement{ActionID: "radio", Options: options, InitialOption: &options[1]},
+		&blockkit.OverflowElement{ActionID: "overflow", Options: options, Confirm: confirm},
+		&blockkit.DatePickerElement{ActionID: "date", InitialDate: "2026-01-01"},
+		&blockkit.TimePickerElement{ActionID: "time", InitialTime: "09:00", Timezone: "Asia/Seoul"},
+		&blockkit.DateTimePickerElement{ActionID: "datetime", InitialDateTime: 1767225600},
+		&blockkit.PlainTextInputElement{
+			ActionID:             "text",
+			Multiline:            true,
+			MaxLength:            300,
+			DispatchActionConfig: &blockkit.DispatchActionConfigObject{TriggerActionsOn: []string{"on_enter_pressed"}},
+		},
+		&blockkit.EmailTextInputElement{ActionID: "email"},
+		&blockkit.URLTextInputElement{ActionID: "url"},
+		&blockkit.NumberInputElement{ActionID: "number", IsDecimalAllowed: false, MinValue: "1", MaxValue: "10"},
+	}
+
+	for _, element := range elements {
+		t.Run(string(element.ElementType()), func(t *testing.T) {
+			want, err := json.Marshal(element)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			decoded, err := blockkit.UnmarshalElement(want)
+			if err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if decoded.ElementType() != element.ElementType() {
+				t.Errorf("expected %q, got %q", element.ElementType(), decoded.ElementType())
+			}
+
+			got, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			if !bytes.Equal(want, got) {
+				t.Errorf("round trip mismatch\nwant: %s\ngot:  %s", want, got)
+			}
+		})
+	}
+}
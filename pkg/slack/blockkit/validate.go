@@ -0,0 +1,412 @@
+package blockkit
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Reference
+// https://api.slack.com/reference/block-kit/blocks
+// https://api.slack.com/reference/block-kit/block-elements
+// https://api.slack.com/reference/surfaces/views
+
+// 슬랙에서 허용하는 최대 길이 및 개수.
+const (
+	MaxMessageBlocks     = 50
+	MaxModalBlocks       = 100
+	MaxTextLength        = 3000
+	MaxHeaderTextLength  = 150
+	MaxFieldTextLength   = 2000
+	MaxSectionFields     = 10
+	MaxActionElements    = 25
+	MaxContextElements   = 10
+	MaxIDLength          = 255
+	MaxButtonTextLength  = 75
+	MaxButtonValueLength = 2000
+	MaxURLLength         = 3000
+	MaxOptions           = 100
+	MaxOptionGroups      = 100
+	MaxOptionTextLength  = 75
+	MaxOptionValueLength = 150
+	MaxChoiceOptions     = 10
+	MaxOverflowOptions   = 5
+	MaxModalTitleLength  = 24
+	MaxPrivateMetadata   = 3000
+)
+
+// 블록이 슬랙의 제약 조건을 만족하지 않는 경우 반환하는 에러.
+type ValidationError struct {
+	// 문제가 발생한 위치. (e.g. `blocks[2].elements[0].text`)
+	Path string
+	// 위반한 제약 조건에 대한 설명.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// 검증 과정에서 발견한 모든 에러.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "invalid blocks: " + strings.Join(messages, "; ")
+}
+
+// 메시지에 포함될 블록 목록이 슬랙의 제약 조건을 만족하는지 검사한다.
+// 문제가 있다면 ValidationErrors 를 반환한다.
+func (b Blocks) Validate() error {
+	v := newValidator()
+	v.blocks("blocks", b, MaxMessageBlocks)
+	return v.err()
+}
+
+// 모달 뷰가 슬랙의 제약 조건을 만족하는지 검사한다.
+// 문제가 있다면 ValidationErrors 를 반환한다.
+func (m *ModalView) Validate() error {
+	v := newValidator()
+	v.text("title", &m.Title, MaxModalTitleLength, true)
+	if m.Close != nil {
+		v.text("close", m.Close, MaxModalTitleLength, true)
+	}
+	if m.Submit != nil {
+		v.text("submit", m.Submit, MaxModalTitleLength, true)
+	}
+	v.length("callback_id", m.CallbackID, MaxIDLength)
+	v.length("private_metadata", m.PrivateMetadata, MaxPrivateMetadata)
+	v.blocks("blocks", m.Blocks, MaxModalBlocks)
+
+	if m.Submit == nil {
+		for i, block := range m.Blocks {
+			if block.BlockType() == BlockTypeInput {
+				v.errorf(fmt.Sprintf("blocks[%d]", i), "input block requires submit button in modal")
+				break
+			}
+		}
+	}
+	return v.err()
+}
+
+type validator struct {
+	errs     ValidationErrors
+	blockIDs map[string]string
+}
+
+func newValidator() *validator {
+	return &validator{blockIDs: make(map[string]string)}
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) errorf(path string, format string, args ...any) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) length(path string, value string, max int) {
+	if n := utf8.RuneCountInString(value); n > max {
+		v.errorf(path, "length %d exceeds maximum %d", n, max)
+	}
+}
+
+func (v *validator) required(path string, value string) {
+	if value == "" {
+		v.errorf(path, "required")
+	}
+}
+
+func (v *validator) text(path string, t *TextObject, max int, plainOnly bool) {
+	switch t.Type {
+	case TextTypePlainText:
+	case TextTypeMarkdown:
+		if plainOnly {
+			v.errorf(path+".type", "must be %s", TextTypePlainText)
+		}
+	default:
+		v.errorf(path+".type", "undefined text type %q", t.Type)
+	}
+	if t.Text == "" {
+		v.errorf(path+".text", "required")
+	}
+	v.length(path+".text", t.Text, max)
+}
+
+func (v *validator) blocks(path string, blocks []SlackBlock, max int) {
+	if len(blocks) > max {
+		v.errorf(path, "%d blocks exceeds maximum %d", len(blocks), max)
+	}
+	for i, block := range blocks {
+		v.block(fmt.Sprintf("%s[%d]", path, i), block)
+	}
+}
+
+func (v *validator) blockID(path string, id string) {
+	if id == "" {
+		return
+	}
+	v.length(path+".block_id", id, MaxIDLength)
+	if prev, ok := v.blockIDs[id]; ok {
+		v.errorf(path+".block_id", "duplicate block_id %q (also used in %s)", id, prev)
+		return
+	}
+	v.blockIDs[id] = path
+}
+
+func (v *validator) block(path string, block SlackBlock) {
+	if block == nil {
+		v.errorf(path, "required")
+		return
+	}
+
+	switch b := block.(type) {
+	case *HeaderBlock:
+		v.blockID(path, b.BlockID)
+		v.text(path+".text", &b.Text, MaxHeaderTextLength, true)
+	case *SectionBlock:
+		v.blockID(path, b.BlockID)
+		if b.Text == nil && len(b.Fields) == 0 {
+			v.errorf(path, "either text or fields is required")
+		}
+		if b.Text != nil {
+			v.text(path+".text", b.Text, MaxTextLength, false)
+		}
+		if len(b.Fields) > MaxSectionFields {
+			v.errorf(path+".fields", "%d fields exceeds maximum %d", len(b.Fields), MaxSectionFields)
+		}
+		for i := range b.Fields {
+			v.text(fmt.Sprintf("%s.fields[%d]", path, i), &b.Fields[i], MaxFieldTextLength, false)
+		}
+		if b.Accessory != nil {
+			v.element(path+".accessory", b.Accessory, nil)
+		}
+	case *ActionBlock:
+		v.blockID(path, b.BlockID)
+		if len(b.Elements) == 0 {
+			v.errorf(path+".elements", "required")
+		}
+		if len(b.Elements) > MaxActionElements {
+			v.errorf(path+".elements", "%d elements exceeds maximum %d", len(b.Elements), MaxActionElements)
+		}
+		actionIDs := make(map[string]string)
+		for i, element := range b.Elements {
+			v.element(fmt.Sprintf("%s.elements[%d]", path, i), element, actionIDs)
+		}
+	case *DividerBlock:
+	case *ContextBlock:
+		v.blockID(path, b.BlockID)
+		if len(b.Elements) == 0 {
+			v.errorf(path+".elements", "required")
+		}
+		if len(b.Elements) > MaxContextElements {
+			v.errorf(path+".elements", "%d elements exceeds maximum %d", len(b.Elements), MaxContextElements)
+		}
+		for i, element := range b.Elements {
+			elementPath := fmt.Sprintf("%s.elements[%d]", path, i)
+			switch e := element.(type) {
+			case *TextObject:
+				v.text(elementPath, e, MaxTextLength, false)
+			case *ImageElement:
+				v.element(elementPath, e, nil)
+			default:
+				v.errorf(elementPath, "required")
+			}
+		}
+	case *ImageBlock:
+		v.blockID(path, b.BlockID)
+		v.required(path+".alt_text", b.AltText)
+		v.length(path+".alt_text", b.AltText, MaxFieldTextLength)
+		if b.ImageURL == "" && b.SlackFile == nil {
+			v.errorf(path, "either image_url or slack_file is required")
+		}
+		v.length(path+".image_url", b.ImageURL, MaxURLLength)
+		if b.Title != nil {
+			v.text(path+".title", b.Title, MaxFieldTextLength, true)
+		}
+	case *InputBlock:
+		v.blockID(path, b.BlockID)
+		v.text(path+".label", &b.Label, MaxFieldTextLength, true)
+		if b.Hint != nil {
+			v.text(path+".hint", b.Hint, MaxFieldTextLength, true)
+		}
+		if b.Element == nil {
+			v.errorf(path+".element", "required")
+		} else {
+			v.element(path+".element", b.Element, nil)
+		}
+	case *RichTextBlock:
+		v.blockID(path, b.BlockID)
+		if len(b.Elements) == 0 {
+			v.errorf(path+".elements", "required")
+		}
+	case *VideoBlock:
+		v.blockID(path, b.BlockID)
+		v.required(path+".alt_text", b.AltText)
+		v.text(path+".title", &b.Title, 200, true)
+		v.required(path+".thumbnail_url", b.ThumbnailURL)
+		v.required(path+".video_url", b.VideoURL)
+	case *FileBlock:
+		v.blockID(path, b.BlockID)
+		v.required(path+".external_id", b.ExternalID)
+		v.required(path+".source", b.Source)
+	default:
+		v.errorf(path, "undefined block type %q", block.BlockType())
+	}
+}
+
+func (v *validator) actionID(path string, id string, actionIDs map[string]string) {
+	if id == "" {
+		return
+	}
+	v.length(path+".action_id", id, MaxIDLength)
+	if actionIDs == nil {
+		return
+	}
+	if prev, ok := actionIDs[id]; ok {
+		v.errorf(path+".action_id", "duplicate action_id %q (also used in %s)", id, prev)
+		return
+	}
+	actionIDs[id] = path
+}
+
+func (v *validator) options(path string, options []OptionBlockObject, min, max int) {
+	if len(options) < min {
+		v.errorf(path, "%d options is less than minimum %d", len(options), min)
+	}
+	if len(options) > max {
+		v.errorf(path, "%d options exceeds maximum %d", len(options), max)
+	}
+	for i := range options {
+		optionPath := fmt.Sprintf("%s[%d]", path, i)
+		v.text(optionPath+".text", &options[i].Text, MaxOptionTextLength, false)
+		v.required(optionPath+".value", options[i].Value)
+		v.length(optionPath+".value", options[i].Value, MaxOptionValueLength)
+	}
+}
+
+// static select 와 multi static select 의 options 또는 option_groups 를 검사한다. 둘 중 하나만 사용해야 한다.
+func (v *validator) staticOptions(path string, options []OptionBlockObject, groups []OptionGroupObject) {
+	if len(options) == 0 && len(groups) == 0 {
+		v.errorf(path, "either options or option_groups is required")
+	}
+	if len(options) > 0 && len(groups) > 0 {
+		v.errorf(path, "options and option_groups cannot be used together")
+	}
+	v.options(path+".options", options, 0, MaxOptions)
+	if len(groups) > MaxOptionGroups {
+		v.errorf(path+".option_groups", "%d option groups exceeds maximum %d", len(groups), MaxOptionGroups)
+	}
+	for i := range groups {
+		groupPath := fmt.Sprintf("%s.option_groups[%d]", path, i)
+		v.text(groupPath+".label", &groups[i].Label, MaxOptionTextLength, true)
+		v.options(groupPath+".options", groups[i].Options, 1, MaxOptions)
+	}
+}
+
+func (v *validator) placeholder(path string, t *TextObject) {
+	if t != nil {
+		v.text(path+".placeholder", t, MaxHeaderTextLength, true)
+	}
+}
+
+func (v *validator) element(path string, element SlackBlockElement, actionIDs map[string]string) {
+	if element == nil {
+		v.errorf(path, "required")
+		return
+	}
+
+	switch e := element.(type) {
+	case *ButtonElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.text(path+".text", &e.Text, MaxButtonTextLength, true)
+		v.length(path+".value", e.Value, MaxButtonValueLength)
+		v.length(path+".url", e.URL, MaxURLLength)
+		v.length(path+".accessibility_label", e.Label, MaxButtonTextLength)
+		switch e.Style {
+		case ButtonStyleDefault, ButtonStylePrimary, ButtonStyleDanger:
+		default:
+			v.errorf(path+".style", "undefined button style %q", e.Style)
+		}
+	case *ImageElement:
+		v.required(path+".alt_text", e.AltText)
+		v.required(path+".image_url", e.ImageURL)
+		v.length(path+".image_url", e.ImageURL, MaxURLLength)
+	case *SelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, &e.Placeholder)
+		v.staticOptions(path, e.Options, e.OptionGroups)
+	case *MultiStaticSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+		v.staticOptions(path, e.Options, e.OptionGroups)
+	case *CheckboxesElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.options(path+".options", e.Options, 1, MaxChoiceOptions)
+	case *RadioButtonsElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.options(path+".options", e.Options, 1, MaxChoiceOptions)
+	case *OverflowElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.options(path+".options", e.Options, 1, MaxOverflowOptions)
+	case *ExternalSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *UsersSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *ConversationsSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *ChannelsSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *MultiExternalSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *MultiUsersSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *MultiConversationsSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *MultiChannelsSelectElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *DatePickerElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *TimePickerElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *DateTimePickerElement:
+		v.actionID(path, e.ActionID, actionIDs)
+	case *PlainTextInputElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+		if e.MinLength > MaxTextLength {
+			v.errorf(path+".min_length", "%d exceeds maximum %d", e.MinLength, MaxTextLength)
+		}
+		if e.MaxLength > 0 && e.MinLength > e.MaxLength {
+			v.errorf(path+".min_length", "must not be greater than max_length")
+		}
+	case *EmailTextInputElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *URLTextInputElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	case *NumberInputElement:
+		v.actionID(path, e.ActionID, actionIDs)
+		v.placeholder(path, e.Placeholder)
+	default:
+		v.errorf(path, "undefined element type %q", element.ElementType())
+	}
+}
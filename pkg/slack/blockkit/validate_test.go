@@ -0,0 +1,317 @@
+package blockkit_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+func plainText(text string) blockkit.TextObject {
+	return blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: text}
+}
+
+func button(actionID string) *blockkit.ButtonElement {
+	return &blockkit.ButtonElement{ActionID: actionID, Text: plainText("실행")}
+}
+
+func options(n int) []blockkit.OptionBlockObject {
+	options := make([]blockkit.OptionBlockObject, 0, n)
+	for i := range n {
+		options = append(options, blockkit.OptionBlockObject{Text: plainText("지역"), Value: strings.Repeat("a", i+1)})
+	}
+	return options
+}
+
+func optionGroups(n int, options []blockkit.OptionBlockObject) []blockkit.OptionGroupObject {
+	groups := make([]blockkit.OptionGroupObject, 0, n)
+	for range n {
+		groups = append(groups, blockkit.OptionGroupObject{Label: plainText("시/도"), Options: options})
+	}
+	return groups
+}
+
+func actions(elements ...blockkit.SlackBlockElement) blockkit.Blocks {
+	return blockkit.Blocks{&blockkit.ActionBlock{Elements: elements}}
+}
+
+func repeatBlocks(n int) blockkit.Blocks {
+	blocks := make(blockkit.Blocks, 0, n)
+	for range n {
+		blocks = append(blocks, &blockkit.DividerBlock{})
+	}
+	return blocks
+}
+
+func TestBlocksValidate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		blocks   blockkit.Blocks
+		wantPath string
+	}{
+		{
+			desc: "valid message",
+			blocks: blockkit.Blocks{
+				blockkit.NewHeaderBlock("⭐️ 지원 기능"),
+				&blockkit.DividerBlock{},
+				&blockkit.SectionBlock{
+					Text:      &blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: "*공휴일 안내*"},
+					Accessory: button("holiday"),
+				},
+				blockkit.NewContextBlock("📡 출처"),
+				&blockkit.ActionBlock{
+					Elements: []blockkit.SlackBlockElement{button("manual"), button("done")},
+				},
+			},
+		},
+		{
+			desc:     "too many blocks",
+			blocks:   repeatBlocks(blockkit.MaxMessageBlocks + 1),
+			wantPath: "blocks",
+		},
+		{
+			desc:     "header too long",
+			blocks:   blockkit.Blocks{blockkit.NewHeaderBlock(strings.Repeat("가", blockkit.MaxHeaderTextLength+1))},
+			wantPath: "blocks[0].text.text",
+		},
+		{
+			desc: "header with markdown",
+			blocks: blockkit.Blocks{
+				&blockkit.HeaderBlock{Text: blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: "*제목*"}},
+			},
+			wantPath: "blocks[0].text.type",
+		},
+		{
+			desc: "section text too long",
+			blocks: blockkit.Blocks{
+				&blockkit.SectionBlock{
+					Text: &blockkit.TextObject{Type: blockkit.TextTypeMarkdown, Text: strings.Repeat("a", blockkit.MaxTextLength+1)},
+				},
+			},
+			wantPath: "blocks[0].text.text",
+		},
+		{
+			desc:     "section without text and fields",
+			blocks:   blockkit.Blocks{&blockkit.SectionBlock{}},
+			wantPath: "blocks[0]",
+		},
+		{
+			desc: "duplicate block id",
+			blocks: blockkit.Blocks{
+				&blockkit.HeaderBlock{BlockID: "same", Text: plainText("a")},
+				&blockkit.HeaderBlock{BlockID: "same", Text: plainText("b")},
+			},
+			wantPath: "blocks[1].block_id",
+		},
+		{
+			desc: "duplicate action id",
+			blocks: blockkit.Blocks{
+				&blockkit.ActionBlock{
+					Elements: []blockkit.SlackBlockElement{button("done"), button("done")},
+				},
+			},
+			wantPath: "blocks[0].elements[1].action_id",
+		},
+		{
+			desc: "too many actions",
+			blocks: func() blockkit.Blocks {
+				elements := make([]blockkit.SlackBlockElement, 0, blockkit.MaxActionElements+1)
+				for i := range blockkit.MaxActionElements + 1 {
+					elements = append(elements, button(strings.Repeat("a", i+1)))
+				}
+				return blockkit.Blocks{&blockkit.ActionBlock{Elements: elements}}
+			}(),
+			wantPath: "blocks[0].elements",
+		},
+		{
+			desc: "undefined button style",
+			blocks: blockkit.Blocks{
+				&blockkit.ActionBlock{
+					Elements: []blockkit.SlackBlockElement{
+						&blockkit.ButtonElement{Text: plainText("삭제"), Style: "Danger"},
+					},
+				},
+			},
+			wantPath: "blocks[0].elements[0].style",
+		},
+		{
+			desc: "select without options",
+			blocks: blockkit.Blocks{
+				&blockkit.ActionBlock{
+					Elements: []blockkit.SlackBlockElement{
+						&blockkit.SelectElement{ActionID: "region", Placeholder: plainText("지역")},
+					},
+				},
+			},
+			wantPath: "blocks[0].elements[0]",
+		},
+		{
+			desc: "select with option groups",
+			blocks: actions(
+				&blockkit.SelectElement{ActionID: "region", Placeholder: plainText("지역"), OptionGroups: optionGroups(2, options(3))},
+				&blockkit.MultiStaticSelectElement{ActionID: "regions", OptionGroups: optionGroups(blockkit.MaxOptionGroups, options(1))},
+			),
+		},
+		{
+			desc: "select with options and option groups",
+			blocks: actions(
+				&blockkit.SelectElement{ActionID: "region", Placeholder: plainText("지역"), Options: options(1), OptionGroups: optionGroups(1, options(1))},
+			),
+			wantPath: "blocks[0].elements[0]",
+		},
+		{
+			desc: "multi select with options and option groups",
+			blocks: actions(
+				&blockkit.MultiStaticSelectElement{ActionID: "regions", Options: options(1), OptionGroups: optionGroups(1, options(1))},
+			),
+			wantPath: "blocks[0].elements[0]",
+		},
+		{
+			desc: "multi select without options",
+			blocks: actions(
+				&blockkit.MultiStaticSelectElement{ActionID: "regions"},
+			),
+			wantPath: "blocks[0].elements[0]",
+		},
+		{
+			desc: "too many option groups",
+			blocks: actions(
+				&blockkit.SelectElement{ActionID: "region", Placeholder: plainText("지역"), OptionGroups: optionGroups(blockkit.MaxOptionGroups+1, options(1))},
+			),
+			wantPath: "blocks[0].elements[0].option_groups",
+		},
+		{
+			desc: "too many options in multi select option group",
+			blocks: actions(
+				&blockkit.MultiStaticSelectElement{ActionID: "regions", OptionGroups: optionGroups(1, options(blockkit.MaxOptions+1))},
+			),
+			wantPath: "blocks[0].elements[0].option_groups[0].options",
+		},
+		{
+			desc: "empty option group",
+			blocks: actions(
+				&blockkit.MultiStaticSelectElement{ActionID: "regions", OptionGroups: optionGroups(1, nil)},
+			),
+			wantPath: "blocks[0].elements[0].option_groups[0].options",
+		},
+		{
+			desc: "option group label too long",
+			blocks: actions(
+				&blockkit.MultiStaticSelectElement{ActionID: "regions", OptionGroups: []blockkit.OptionGroupObject{
+					{Label: plainText(strings.Repeat("가", blockkit.MaxOptionTextLength+1)), Options: options(1)},
+				}},
+			),
+			wantPath: "blocks[0].elements[0].option_groups[0].label.text",
+		},
+		{
+			desc:     "image without url",
+			blocks:   blockkit.Blocks{&blockkit.ImageBlock{AltText: "map"}},
+			wantPath: "blocks[0]",
+		},
+		{
+			desc:     "input without element",
+			blocks:   blockkit.Blocks{&blockkit.InputBlock{Label: plainText("사유")}},
+			wantPath: "blocks[0].element",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.blocks.Validate()
+			if tc.wantPath == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var errs blockkit.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected ValidationErrors, got %v", err)
+			}
+			for _, e := range errs {
+				if e.Path == tc.wantPath {
+					return
+				}
+			}
+			t.Errorf("expected error at %q, got %v", tc.wantPath, err)
+		})
+	}
+}
+
+func TestModalViewValidate(t *testing.T) {
+	input := &blockkit.InputBlock{
+		Label:   plainText("사유"),
+		Element: &blockkit.PlainTextInputElement{ActionID: "reason"},
+	}
+	submit := plainText("제출")
+
+	testCases := []struct {
+		desc     string
+		view     *blockkit.ModalView
+		wantPath string
+	}{
+		{
+			desc: "valid modal",
+			view: &blockkit.ModalView{
+				Title:  plainText("휴가 신청"),
+				Blocks: blockkit.Blocks{input},
+				Submit: &submit,
+			},
+		},
+		{
+			desc: "modal allows more blocks than message",
+			view: &blockkit.ModalView{
+				Title:  plainText("목록"),
+				Blocks: repeatBlocks(blockkit.MaxModalBlocks),
+			},
+		},
+		{
+			desc: "too many blocks",
+			view: &blockkit.ModalView{
+				Title:  plainText("목록"),
+				Blocks: repeatBlocks(blockkit.MaxModalBlocks + 1),
+			},
+			wantPath: "blocks",
+		},
+		{
+			desc: "title too long",
+			view: &blockkit.ModalView{
+				Title: plainText(strings.Repeat("가", blockkit.MaxModalTitleLength+1)),
+			},
+			wantPath: "title.text",
+		},
+		{
+			desc: "input without submit",
+			view: &blockkit.ModalView{
+				Title:  plainText("휴가 신청"),
+				Blocks: blockkit.Blocks{input},
+			},
+			wantPath: "blocks[0]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.view.Validate()
+			if tc.wantPath == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var errs blockkit.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected ValidationErrors, got %v", err)
+			}
+			for _, e := range errs {
+				if e.Path == tc.wantPath {
+					return
+				}
+			}
+			t.Errorf("expected error at %q, got %v", tc.wantPath, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package blockkit
+
+import (
+	"encoding/json"
+)
+
+// Reference
+// https://api.slack.com/reference/surfaces/views
+
+type ViewType string
+
+const (
+	ViewTypeModal ViewType = "modal"
+)
+
+// Modals provide focused spaces ideal for requesting and collecting data from users,
+// or temporarily displaying dynamic and interactive information.
+type ModalView struct {
+	// The title that appears in the top-left of the modal.
+	// Must be a `plain_text` text element with a max length of 24 characters.
+	Title TextObject `json:"title"`
+	// An array of blocks that defines the content of the view. Max of 100 blocks.
+	Blocks Blocks `json:"blocks"`
+	// An optional `plain_text` element that defines the text displayed in the close button
+	// at the bottom-right of the view. Max length of 24 characters.
+	Close *TextObject `json:"close,omitempty"`
+	// An optional `plain_text` element that defines the text displayed in the submit button
+	// at the bottom-right of the view. submit is required when an input block is within the blocks array.
+	// Max length of 24 characters.
+	Submit *TextObject `json:"submit,omitempty"`
+	// An optional string that will be sent to your app in `view_submission` and `block_actions` events.
+	// Max length of 3000 characters.
+	PrivateMetadata string `json:"private_metadata,omitempty"`
+	// An identifier to recognize interactions and submissions of this particular view.
+	// Don't use this to store sensitive information (use private_metadata instead).
+	// Max length of 255 characters.
+	CallbackID string `json:"callback_id,omitempty"`
+	// When set to true, clicking on the close button will clear all views in a modal
+	// and close it. Defaults to false.
+	ClearOnClose bool `json:"clear_on_close,omitempty"`
+	// Indicates whether Slack will send your request URL a `view_closed` event
+	// when a user clicks the close button. Defaults to false.
+	NotifyOnClose bool `json:"notify_on_close,omitempty"`
+	// A custom identifier that must be unique for all views on a per-team basis.
+	ExternalID string `json:"external_id,omitempty"`
+	// When set to true, disables the submit button until the user has completed
+	// one or more inputs. Defaults to false.
+	SubmitDisabled bool `json:"submit_disabled,omitempty"`
+}
+
+func NewModalView(title string, blocks ...SlackBlock) *ModalView {
+	return &ModalView{
+		Title: TextObject{
+			Type:  TextTypePlainText,
+			Text:  title,
+			Emoji: true,
+		},
+		Blocks: blocks,
+	}
+}
+
+func (m *ModalView) ViewType() ViewType {
+	return ViewTypeModal
+}
+
+func (m *ModalView) MarshalJSON() ([]byte, error) {
+	raw := struct {
+		ModalView
+		Type ViewType `json:"type"`
+	}{
+		ModalView: *m,
+		Type:      m.ViewType(),
+	}
+	return json.Marshal(raw)
+}
@@ -0,0 +1,116 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+// 슬랙은 3초 안에 응답을 받지 못하면 사용자에게 오류를 보여주므로, 전송 시간을 고려해 여유를 둔다.
+const ackTimeout = 2500 * time.Millisecond
+
+// 읽기 루프와 응답 고루틴이 함께 쓸 수 있도록 쓰기를 직렬화한 웹소켓 연결.
+type socket struct {
+	*websocket.Conn
+	mu sync.Mutex
+	// payload 를 만들어 응답하고 있는 고루틴. 읽기 루프가 끝나기 전에 기다린다.
+	pending sync.WaitGroup
+}
+
+// 이벤트를 전달받았음을 슬랙에 응답하는 방법. 소켓 모드와 HTTP 모드가 각각 구현한다.
+type acknowledger interface {
+	// payload 를 담아 응답한다. payload 가 nil 이면 빈 응답을 보낸다.
+	ack(payload any) error
+	// 응답할 payload 를 만드는 fn 을 실행한다.
+	goAck(fn func())
+}
+
+// 소켓 모드 envelope 에 대한 응답.
+type envelopeAck struct {
+	socket     *socket
+	envelopeID string
+}
+
+func (e *envelopeAck) ack(payload any) error {
+	return e.socket.ack(e.envelopeID, payload)
+}
+
+// payload 를 만드는 동안 다른 envelope 을 계속 읽을 수 있도록 따로 실행한다.
+func (e *envelopeAck) goAck(fn func()) {
+	e.socket.pending.Go(fn)
+}
+
+// envelope 에 응답한다. payload 가 nil 이면 빈 응답을 보낸다.
+func (s *socket) ack(envelopeID string, payload any) error {
+	response := map[string]any{
+		"envelope_id": envelopeID,
+	}
+	if payload != nil {
+		response["payload"] = payload
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.WriteJSON(response)
+}
+
+// build 로 만든 payload 를 담아 응답하고 payload 를 반환한다.
+// build 가 nil 을 반환하거나 패닉이 발생하면 빈 응답을 보낸다.
+func ackWith[T any](b *Bot, a acknowledger, build func(ctx context.Context) *T) *T {
+	payload := buildAck(b, build)
+
+	var err error
+	if payload != nil {
+		err = a.ack(payload)
+	} else {
+		err = a.ack(nil)
+	}
+	if err != nil {
+		slog.Error("failed to ack with payload", slog.Any("error", err))
+	}
+	return payload
+}
+
+func buildAck[T any](b *Bot, build func(ctx context.Context) *T) (payload *T) {
+	ctx, cancel := context.WithTimeout(b.handlerCtx, ackTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("recovered from ack handler panic", slog.Any("error", fmt.Errorf("%v", r)))
+			payload = nil
+		}
+	}()
+
+	start := time.Now()
+	payload = build(ctx)
+	if elapsed := time.Since(start); elapsed > ackTimeout {
+		slog.Warn("ack handler took too long", slog.Duration("elapsed", elapsed))
+	}
+	return payload
+}
+
+// 커맨드 응답 메시지를 검사하고 텍스트가 없으면 블록에서 만든다.
+func commandAckPayload(payload *slack.InteractiveResponsePayload) *slack.InteractiveResponsePayload {
+	if payload == nil {
+		return nil
+	}
+	// 잘못된 블록을 응답하면 슬랙이 커맨드 자체를 실패로 처리하므로 빈 응답을 보낸다.
+	if err := payload.Blocks.Validate(); err != nil {
+		slog.Error("invalid command ack payload", slog.Any("error", err))
+		return nil
+	}
+	response := *payload
+	if response.Text == "" {
+		response.Text = blockkit.RenderText(response.Blocks)
+	}
+	return &response
+}
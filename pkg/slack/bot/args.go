@@ -0,0 +1,184 @@
+package bot
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+)
+
+// 슬래시 커맨드 텍스트에서 커맨드 이름을 제외한 인자.
+// 각 단어는 아래 필드 중 하나에만 담긴다.
+type Args struct {
+	// 순서대로 입력한 일반 인자. 따옴표로 감싼 문자열은 공백을 포함한 하나의 인자가 된다.
+	Positional []string
+	// `--name` 또는 `--name=value` 형식의 플래그. 값이 없으면 "true" 가 담긴다.
+	Flags map[string]string
+	// `key=value` 형식의 인자.
+	Values map[string]string
+	// 사용자 멘션의 ID. (e.g. `<@U0LAN0Z89|jarvis>` 의 "U0LAN0Z89")
+	Users []string
+	// 채널 멘션의 ID. (e.g. `<#C024BE7LR|general>` 의 "C024BE7LR")
+	Channels []string
+	// 날짜 인자. (e.g. `2025-01-01`, `2025.1.1`, `오늘`, `내일`)
+	Dates []time.Time
+}
+
+// 첫번째 일반 인자를 반환한다. 없으면 빈 문자열을 반환한다.
+func (a Args) First() string {
+	if len(a.Positional) == 0 {
+		return ""
+	}
+	return a.Positional[0]
+}
+
+// 플래그가 입력되었는지 확인한다.
+func (a Args) HasFlag(name string) bool {
+	_, ok := a.Flags[name]
+	return ok
+}
+
+type token struct {
+	text string
+	// 따옴표로 시작한 단어. 플래그, 멘션, 날짜로 해석하지 않는다.
+	quoted bool
+}
+
+// 슬랙 클라이언트는 따옴표를 둥근 따옴표로 바꿔서 보내기도 하므로 함께 처리한다.
+var quotePairs = map[rune]rune{
+	'"':      '"',
+	'\'':     '\'',
+	'\u201C': '\u201D',
+	'\u2018': '\u2019',
+}
+
+// 텍스트를 공백 단위로 나눈다. 따옴표로 감싼 부분은 공백을 포함한 하나의 단어로 만든다.
+func tokenize(text string) []token {
+	var (
+		tokens  []token
+		builder strings.Builder
+		current token
+		started bool
+		closing rune
+	)
+	flush := func() {
+		if started {
+			current.text = builder.String()
+			tokens = append(tokens, current)
+		}
+		builder.Reset()
+		current = token{}
+		started = false
+	}
+
+	for _, r := range text {
+		switch {
+		case closing != 0:
+			if r == closing {
+				closing = 0
+			} else {
+				builder.WriteRune(r)
+			}
+		case unicode.IsSpace(r):
+			flush()
+		case quotePairs[r] != 0:
+			if !started {
+				current.quoted = true
+			}
+			started = true
+			closing = quotePairs[r]
+		default:
+			started = true
+			builder.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+var (
+	userMentionPattern    = regexp.MustCompile(`^<@([UW][A-Z0-9]+)(\|[^>]*)?>$`)
+	channelMentionPattern = regexp.MustCompile(`^<#(C[A-Z0-9]+)(\|[^>]*)?>$`)
+	keyPattern            = regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)
+)
+
+// 날짜로 해석하는 형식.
+var dateLayouts = []string{"2006-1-2", "2006.1.2", "2006/1/2"}
+
+// 오늘을 기준으로 한 날짜 표현.
+var relativeDays = map[string]int{
+	"어제": -1,
+	"오늘": 0,
+	"내일": 1,
+	"모레": 2,
+}
+
+// 슬래시 커맨드 텍스트를 인자로 해석한다.
+func ParseArgs(text string) Args {
+	return parseArgs(tokenize(text), kst.Now())
+}
+
+func parseArgs(tokens []token, now time.Time) Args {
+	var args Args
+	for _, t := range tokens {
+		if t.quoted {
+			args.Positional = append(args.Positional, html.UnescapeString(t.text))
+			continue
+		}
+
+		if m := userMentionPattern.FindStringSubmatch(t.text); m != nil {
+			args.Users = append(args.Users, m[1])
+			continue
+		}
+		if m := channelMentionPattern.FindStringSubmatch(t.text); m != nil {
+			args.Channels = append(args.Channels, m[1])
+			continue
+		}
+
+		// 슬랙은 텍스트의 &, <, > 를 이스케이프해서 보낸다.
+		text := html.UnescapeString(t.text)
+		if name, ok := strings.CutPrefix(text, "--"); ok && name != "" {
+			key, value, found := strings.Cut(name, "=")
+			if !found {
+				value = "true"
+			}
+			if args.Flags == nil {
+				args.Flags = make(map[string]string)
+			}
+			args.Flags[key] = value
+			continue
+		}
+		if key, value, found := strings.Cut(text, "="); found && keyPattern.MatchString(key) {
+			if args.Values == nil {
+				args.Values = make(map[string]string)
+			}
+			args.Values[key] = value
+			continue
+		}
+		if date, ok := parseDate(text, now); ok {
+			args.Dates = append(args.Dates, date)
+			continue
+		}
+
+		args.Positional = append(args.Positional, text)
+	}
+	return args
+}
+
+// 날짜 형식의 단어를 한국 표준시 자정으로 해석한다.
+func parseDate(text string, now time.Time) (time.Time, bool) {
+	if offset, ok := relativeDays[text]; ok {
+		year, month, day := kst.KST(now).Date()
+		return time.Date(year, month, day+offset, 0, 0, 0, 0, kst.Zone), true
+	}
+	for _, layout := range dateLayouts {
+		if date, err := time.ParseInLocation(layout, text, kst.Zone); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
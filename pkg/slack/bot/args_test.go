@@ -0,0 +1,100 @@
+package bot_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+)
+
+func TestParseArgs(t *testing.T) {
+	year, month, day := kst.Now().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, kst.Zone)
+
+	testCases := []struct {
+		desc  string
+		text  string
+		want  bot.Args
+		first string
+	}{
+		{
+			desc:  "extra spaces",
+			text:  "  서울   부산 ",
+			want:  bot.Args{Positional: []string{"서울", "부산"}},
+			first: "서울",
+		},
+		{
+			desc:  "quoted strings",
+			text:  `"서울 강남" '부산' “제주 시내”`,
+			want:  bot.Args{Positional: []string{"서울 강남", "부산", "제주 시내"}},
+			first: "서울 강남",
+		},
+		{
+			desc: "flags",
+			text: "--verbose --limit=3",
+			want: bot.Args{Flags: map[string]string{"verbose": "true", "limit": "3"}},
+		},
+		{
+			desc: "key value",
+			text: `지역=서울 메모="점심 약속" a=b=c`,
+			want: bot.Args{Values: map[string]string{"지역": "서울", "메모": "점심 약속", "a": "b=c"}},
+		},
+		{
+			desc: "mentions",
+			text: "<@U0LAN0Z89|jarvis> <@W123> <#C024BE7LR|general> <#C1>",
+			want: bot.Args{
+				Users:    []string{"U0LAN0Z89", "W123"},
+				Channels: []string{"C024BE7LR", "C1"},
+			},
+		},
+		{
+			desc: "dates",
+			text: "2025-01-02 2025.3.1 오늘 내일",
+			want: bot.Args{Dates: []time.Time{
+				time.Date(2025, 1, 2, 0, 0, 0, 0, kst.Zone),
+				time.Date(2025, 3, 1, 0, 0, 0, 0, kst.Zone),
+				today,
+				today.AddDate(0, 0, 1),
+			}},
+		},
+		{
+			desc:  "quoted words are positional",
+			text:  `"--verbose" "오늘" "<@U0LAN0Z89>"`,
+			want:  bot.Args{Positional: []string{"--verbose", "오늘", "<@U0LAN0Z89>"}},
+			first: "--verbose",
+		},
+		{
+			desc:  "escaped text",
+			text:  "a&amp;b &lt;tag&gt; 2025-13-01",
+			want:  bot.Args{Positional: []string{"a&b", "<tag>", "2025-13-01"}},
+			first: "a&b",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := bot.ParseArgs(tc.text)
+			if !slices.Equal(got.Positional, tc.want.Positional) {
+				t.Errorf("expected positional %q, got %q", tc.want.Positional, got.Positional)
+			}
+			if !maps.Equal(got.Flags, tc.want.Flags) {
+				t.Errorf("expected flags %v, got %v", tc.want.Flags, got.Flags)
+			}
+			if !maps.Equal(got.Values, tc.want.Values) {
+				t.Errorf("expected values %v, got %v", tc.want.Values, got.Values)
+			}
+			if !slices.Equal(got.Users, tc.want.Users) || !slices.Equal(got.Channels, tc.want.Channels) {
+				t.Errorf("unexpected mentions: users=%q channels=%q", got.Users, got.Channels)
+			}
+			if !slices.EqualFunc(got.Dates, tc.want.Dates, time.Time.Equal) {
+				t.Errorf("expected dates %v, got %v", tc.want.Dates, got.Dates)
+			}
+			if got.First() != tc.first {
+				t.Errorf("expected first %q, got %q", tc.first, got.First())
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package bot
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"math/rand/v2"
 	"sync"
 	"time"
 
@@ -11,115 +13,510 @@ import (
 	"github.com/joyfuldevs/project-jarvis/pkg/slack"
 )
 
+// 소켓 모드로 전달받은 이벤트를 처리하는 핸들러.
+// ctx 는 이벤트마다 만들어지며, 처리 시간이 제한을 넘거나 봇이 강제로 종료되면 취소된다.
 type EventHandler interface {
-	HandleCommandEvent(payload *slack.SlashCommandEventPayload)
-	HandleInteractiveEvent(payload *slack.InteractiveEventPayload)
+	HandleCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload)
+	HandleInteractiveEvent(ctx context.Context, payload *slack.InteractiveEventPayload)
 }
 
+// 모달 제출 및 닫기 이벤트를 처리하는 핸들러.
+// EventHandler 와 함께 구현하면 view_submission, view_closed 타입의 상호작용 이벤트를 전달받는다.
+type ViewHandler interface {
+	HandleViewSubmission(ctx context.Context, payload *slack.InteractiveEventPayload)
+	HandleViewClosed(ctx context.Context, payload *slack.InteractiveEventPayload)
+}
+
+// 전역 바로가기와 메시지 바로가기를 처리하는 핸들러.
+// EventHandler 와 함께 구현하면 shortcut, message_action 타입의 상호작용 이벤트를 전달받는다.
+type ShortcutHandler interface {
+	HandleShortcut(ctx context.Context, payload *slack.InteractiveEventPayload)
+	HandleMessageShortcut(ctx context.Context, payload *slack.InteractiveEventPayload)
+}
+
+// 슬래시 커맨드에 즉시 보여줄 메시지를 만드는 핸들러.
+// EventHandler 와 함께 구현하면 반환한 메시지를 소켓 응답에 담아 보내고, 이후 HandleCommandEvent 를 호출한다.
+// 3초 안에 응답해야 하므로 오래 걸리는 작업은 HandleCommandEvent 에서 response_url 로 응답한다.
+// nil 을 반환하면 빈 응답을 보낸다. 미들웨어보다 먼저 실행되므로 미들웨어가 거절할 커맨드에도 호출된다.
+type CommandAckHandler interface {
+	AckCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) *slack.InteractiveResponsePayload
+}
+
+// 모달 제출에 즉시 응답하는 핸들러. 입력값의 오류를 표시하거나 모달을 갱신하거나 닫을 때 사용한다.
+// response_action 이 errors 이면 모달이 제출되지 않은 것이므로 HandleViewSubmission 을 호출하지 않는다.
+// nil 을 반환하면 빈 응답을 보내고 모달을 닫는다.
+type ViewSubmissionAckHandler interface {
+	AckViewSubmission(ctx context.Context, payload *slack.InteractiveEventPayload) *slack.ViewSubmissionResponse
+}
+
+// external_select 요소에 표시할 옵션을 제공하는 핸들러.
+// 구현하지 않으면 block_suggestion 이벤트는 HandleInteractiveEvent 로 전달된다.
+type BlockSuggestionHandler interface {
+	HandleBlockSuggestion(ctx context.Context, payload *slack.InteractiveEventPayload) *slack.BlockSuggestionResponse
+}
+
+// Events API 이벤트를 처리하는 핸들러.
+// EventHandler 와 함께 구현하면 멘션, 메시지, 반응 등의 이벤트를 전달받는다.
+type EventsAPIHandler interface {
+	HandleEventsAPIEvent(ctx context.Context, payload *slack.EventsAPIPayload)
+}
+
+type botOptions struct {
+	client         *slack.Client
+	dialer         *websocket.Dialer
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	connections    int
+	dedupWindow    time.Duration
+	workers        int
+	queueSize      int
+	handlerTimeout time.Duration
+	drainTimeout   time.Duration
+	signingSecret  string
+	middlewares    []Middleware
+	recorder       *Recorder
+	observe        func(kind EventKind, duplicated bool)
+	observeDrop    func(kind EventKind)
+}
+
+type Option func(*botOptions)
+
+// 웹소켓 주소를 가져올 때 사용할 슬랙 클라이언트를 지정한다.
+func WithClient(client *slack.Client) Option {
+	return func(opts *botOptions) {
+		opts.client = client
+	}
+}
+
+// 소켓 모드 웹소켓에 연결할 때 사용할 다이얼러를 지정한다.
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(opts *botOptions) {
+		opts.dialer = dialer
+	}
+}
+
+// 연결에 실패했을 때 다시 연결하기 전까지 기다리는 시간의 범위를 지정한다.
+// 실패할 때마다 두 배씩 늘어나며 maxDelay 를 넘지 않는다.
+func WithBackoff(minDelay time.Duration, maxDelay time.Duration) Option {
+	return func(opts *botOptions) {
+		opts.minBackoff = minDelay
+		opts.maxBackoff = maxDelay
+	}
+}
+
+// 동시에 유지할 웹소켓 연결의 수를 지정한다. 기본값은 1 이다.
+// 슬랙은 여러 연결에 이벤트를 나누어 전달하므로, 연결 하나가 갱신되는 동안에도 이벤트를 놓치지 않는다.
+func WithConnections(n int) Option {
+	return func(opts *botOptions) {
+		opts.connections = n
+	}
+}
+
+// 같은 envelope 을 중복으로 처리하지 않도록 기억하는 시간을 지정한다. 기본값은 10분이다.
+func WithDedupWindow(window time.Duration) Option {
+	return func(opts *botOptions) {
+		opts.dedupWindow = window
+	}
+}
+
+// 동시에 실행할 수 있는 핸들러의 수를 지정한다. 기본값은 16 이다.
+func WithWorkers(n int) Option {
+	return func(opts *botOptions) {
+		opts.workers = n
+	}
+}
+
+// 모든 핸들러가 실행중일 때 대기열에 쌓아둘 수 있는 작업의 수를 지정한다. 기본값은 256 이다.
+// 대기열도 가득 차면 작업을 버리고 사용자에게 다시 시도해 달라고 알린다.
+func WithQueueSize(n int) Option {
+	return func(opts *botOptions) {
+		opts.queueSize = n
+	}
+}
+
+// 이벤트 하나를 처리할 수 있는 최대 시간을 지정한다. 기본값은 1분이다.
+func WithHandlerTimeout(timeout time.Duration) Option {
+	return func(opts *botOptions) {
+		opts.handlerTimeout = timeout
+	}
+}
+
+// 종료할 때 처리중인 핸들러를 기다리는 최대 시간을 지정한다. 기본값은 10초이다.
+func WithDrainTimeout(timeout time.Duration) Option {
+	return func(opts *botOptions) {
+		opts.drainTimeout = timeout
+	}
+}
+
+// HTTP 모드에서 요청의 서명을 확인할 때 사용할 signing secret 을 지정한다.
+func WithSigningSecret(secret string) Option {
+	return func(opts *botOptions) {
+		opts.signingSecret = secret
+	}
+}
+
+// 핸들러를 실행할 때 적용할 미들웨어를 추가한다. 먼저 추가한 미들웨어가 바깥쪽에서 실행된다.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(opts *botOptions) {
+		opts.middlewares = append(opts.middlewares, middlewares...)
+	}
+}
+
+// 소켓 모드로 받은 envelope 을 recorder 에 기록한다. 장애를 재현할 때 Bot.Replay 로 다시 처리한다.
+func WithRecorder(recorder *Recorder) Option {
+	return func(opts *botOptions) {
+		opts.recorder = recorder
+	}
+}
+
+// 커맨드, 상호작용, Events API envelope 을 받을 때마다 호출할 함수를 지정한다. 지표를 수집할 때 사용한다.
+// 중복으로 받아 처리하지 않는 envelope 은 duplicated 가 true 이다.
+func WithEnvelopeObserver(observe func(kind EventKind, duplicated bool)) Option {
+	return func(opts *botOptions) {
+		opts.observe = observe
+	}
+}
+
+// 모든 워커가 바빠서 이벤트를 처리하지 않고 버릴 때마다 호출할 함수를 지정한다. 지표를 수집할 때 사용한다.
+func WithDropObserver(observe func(kind EventKind)) Option {
+	return func(opts *botOptions) {
+		opts.observeDrop = observe
+	}
+}
+
+// 소켓 모드 연결 상태.
+type ConnectionState string
+
+const (
+	// 웹소켓 주소를 가져오거나 연결을 시도하고 있는 상태.
+	StateConnecting ConnectionState = "connecting"
+	// 웹소켓에 연결되어 이벤트를 전달받고 있는 상태.
+	StateConnected ConnectionState = "connected"
+	// 슬랙으로부터 연결 종료를 예고받아 새로운 연결로 옮겨가고 있는 상태.
+	StateDraining ConnectionState = "draining"
+	// 실행되지 않았거나 실행이 끝난 상태.
+	StateClosed ConnectionState = "closed"
+)
+
+// 서버로부터 일정시간동안 핑을 받지 못하면 네트워크 연결이 끊어진것으로 판단한다.
+const pingTimeout = 3 * time.Minute
+
 type Bot struct {
 	client      *slack.Client
+	dialer      *websocket.Dialer
 	handler     EventHandler
-	eventCh     chan slack.SlackEvent
-	reconnectCh chan struct{}
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	connections int
+	dedup       *dedup
+	// 미들웨어를 거쳐 handler 를 호출한다.
+	handle HandlerFunc
+	// 받은 envelope 을 기록한다. 없으면 nil 이다.
+	recorder *Recorder
+	// 받은 envelope 을 알린다. 없으면 nil 이다.
+	observe func(kind EventKind, duplicated bool)
+	// 버린 작업을 알린다. 없으면 nil 이다.
+	observeDrop func(kind EventKind)
+
+	workers        int
+	queueSize      int
+	handlerTimeout time.Duration
+	drainTimeout   time.Duration
+
+	jobsMu sync.RWMutex
+	// 실행중인 동안 핸들러에서 처리할 작업을 전달한다. 실행중이 아니면 nil 이다.
+	jobs chan job
+	// 실행중인 동안 핸들러에 전달할 컨텍스트의 부모.
+	handlerCtx context.Context
+
+	signingSecret string
+
+	stateMu sync.RWMutex
+	// 연결마다의 상태. 실행중이 아니면 비어있다.
+	states []ConnectionState
 }
 
-func NewBot(appToken string, botToken string, handler EventHandler) *Bot {
-	return &Bot{
-		client:      &slack.Client{AppToken: appToken, BotToken: botToken},
+func NewBot(appToken string, botToken string, handler EventHandler, opts ...Option) *Bot {
+	options := &botOptions{
+		dialer:         websocket.DefaultDialer,
+		minBackoff:     time.Second,
+		maxBackoff:     2 * time.Minute,
+		connections:    1,
+		dedupWindow:    10 * time.Minute,
+		workers:        16,
+		queueSize:      256,
+		handlerTimeout: time.Minute,
+		drainTimeout:   10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.client == nil {
+		options.client = slack.NewClient(appToken, botToken)
+	}
+	b := &Bot{
+		client:      options.client,
+		dialer:      options.dialer,
 		handler:     handler,
-		eventCh:     make(chan slack.SlackEvent, 1),
-		reconnectCh: make(chan struct{}, 1),
+		minBackoff:  options.minBackoff,
+		maxBackoff:  options.maxBackoff,
+		connections: max(options.connections, 1),
+		dedup:       newDedup(options.dedupWindow),
+
+		workers:        max(options.workers, 1),
+		queueSize:      max(options.queueSize, 0),
+		handlerTimeout: options.handlerTimeout,
+		drainTimeout:   options.drainTimeout,
+
+		signingSecret: options.signingSecret,
+		recorder:      options.recorder,
+		observe:       options.observe,
+		observeDrop:   options.observeDrop,
+	}
+	b.handle = Chain(options.middlewares...)(b.callHandler)
+	return b
+}
+
+// 현재 소켓 모드 연결 상태를 반환한다. 헬스 체크에 사용한다.
+// 여러 연결을 사용하는 경우 하나라도 연결되어 있으면 StateConnected 를 반환한다.
+func (b *Bot) State() ConnectionState {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+
+	if len(b.states) == 0 {
+		return StateClosed
+	}
+	state := StateConnecting
+	for _, s := range b.states {
+		switch s {
+		case StateConnected:
+			return StateConnected
+		case StateDraining:
+			state = StateDraining
+		}
+	}
+	return state
+}
+
+// n 개 연결의 상태를 state 로 초기화한다. n 이 0 이면 실행중이 아닌 것으로 본다.
+func (b *Bot) resetStates(n int, state ConnectionState) {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+
+	b.states = nil
+	for range n {
+		b.states = append(b.states, state)
 	}
 }
 
+func (b *Bot) setState(id int, state ConnectionState) {
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+
+	if b.states[id] != state {
+		slog.Info("connection state changed",
+			slog.Int("connection", id),
+			slog.String("from", string(b.states[id])),
+			slog.String("to", string(state)),
+		)
+		b.states[id] = state
+	}
+}
+
+// 소켓 모드로 슬랙에 연결하고 이벤트를 처리한다.
+// 연결에 실패하거나 연결이 끊어지면 다시 연결하며, ctx 가 취소되거나 토큰이 유효하지 않은 경우에만 종료한다.
+// 종료할 때는 처리중인 핸들러가 끝날 때까지 drainTimeout 만큼 기다린다.
 func (b *Bot) Run(ctx context.Context) error {
+	stop := b.startWorkers(ctx)
+	err := b.runConnections(ctx)
+
+	// 모든 연결이 닫혔으므로 더 이상 작업이 추가되지 않는다.
+	stop()
+	return err
+}
+
+func (b *Bot) runConnections(ctx context.Context) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	b.resetStates(b.connections, StateConnecting)
+	defer b.resetStates(0, StateClosed)
+
+	var wg sync.WaitGroup
+	for id := range b.connections {
+		wg.Go(func() {
+			if err := b.runConnection(ctx, id); err != nil {
+				cancel(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// 부모 ctx 가 취소된 경우가 아니라면 연결 중 하나가 실패한 것이다.
+	if err := context.Cause(ctx); err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return nil
+}
+
+// 하나의 웹소켓 연결을 유지한다. 연결이 끊어지면 다시 연결한다.
+func (b *Bot) runConnection(ctx context.Context, id int) error {
+	attempt := 0
+	var next *websocket.Conn
 	for {
 		if ctx.Err() != nil {
+			if next != nil {
+				_ = next.Close()
+			}
 			return nil
 		}
 
-		resp, err := b.client.GetWebSocketURL(ctx)
-		if err != nil {
-			return err
+		conn := next
+		next = nil
+		if conn == nil {
+			b.setState(id, StateConnecting)
+			c, err := b.connect(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				// 토큰에 문제가 있다면 다시 연결해도 실패하므로 종료한다.
+				if errors.Is(err, slack.ErrInvalidAuth) || errors.Is(err, errNotAuthed) {
+					return err
+				}
+				delay := b.backoff(attempt)
+				attempt++
+				slog.Warn("failed to connect, retrying",
+					slog.Int("connection", id),
+					slog.Any("error", err),
+					slog.Int("attempt", attempt),
+					slog.Duration("delay", delay),
+				)
+				if !sleep(ctx, delay) {
+					return nil
+				}
+				continue
+			}
+			conn = c
 		}
 
-		conn, _, err := websocket.DefaultDialer.Dial(resp.URL, nil)
-		if err != nil {
-			return err
-		}
+		attempt = 0
+		b.setState(id, StateConnected)
+		next = b.serve(ctx, id, conn)
+	}
+}
 
-		connCtx, connCancel := context.WithCancel(ctx)
-		go func() {
-			<-b.reconnectCh
-			connCancel()
-		}()
-		go func() {
-			<-connCtx.Done()
-			_ = conn.Close()
-		}()
+var errNotAuthed = &slack.APIError{Code: "not_authed"}
 
-		wg := &sync.WaitGroup{}
-		wg.Go(func() {
-			b.runReadLoop(connCtx, conn)
-			connCancel()
-		})
-		wg.Go(func() {
-			b.runEventLoop(connCtx, conn)
-			connCancel()
-		})
-		wg.Wait()
+func (b *Bot) connect(ctx context.Context) (*websocket.Conn, error) {
+	resp, err := b.client.GetWebSocketURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, _, err := b.dialer.DialContext(ctx, resp.URL, nil)
+	if err != nil {
+		return nil, err
 	}
+	return conn, nil
 }
 
-func (b *Bot) runReadLoop(ctx context.Context, conn *websocket.Conn) {
-	ctx, cancel := context.WithCancel(ctx)
+// 실패한 횟수에 따라 다시 연결하기 전까지 기다릴 시간을 계산한다.
+// 여러 인스턴스가 동시에 재연결하지 않도록 절반은 무작위로 정한다.
+func (b *Bot) backoff(attempt int) time.Duration {
+	delay := b.minBackoff
+	for range attempt {
+		delay *= 2
+		if delay >= b.maxBackoff {
+			delay = b.maxBackoff
+			break
+		}
+	}
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(half)
+}
 
-	ping := make(chan struct{}, 1)
-	defaultHandler := conn.PingHandler()
-	conn.SetPingHandler(func(appData string) error {
-		ping <- struct{}{}
-		return defaultHandler(appData)
-	})
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
 
+// 연결이 끊어질 때까지 이벤트를 처리한다.
+// 슬랙이 연결 종료를 예고하면 새로운 연결을 먼저 맺고, 기존 연결을 닫은 뒤 새로운 연결을 반환한다.
+func (b *Bot) serve(ctx context.Context, id int, conn *websocket.Conn) *websocket.Conn {
+	connCtx, cancel := context.WithCancel(ctx)
 	go func() {
-		ticker := time.NewTicker(3 * time.Minute)
-		defer ticker.Stop()
-		// 서버로부터 일정시간동안 핑을 받지 못하면 네트워크 연결이 끊어진것으로 판단한다.
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				slog.Warn("ping timeout")
-				cancel()
-				return
-			case <-ping:
-				ticker.Reset(3 * time.Minute)
-			}
-		}
+		<-connCtx.Done()
+		_ = conn.Close()
+	}()
+
+	refresh := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.runReadLoop(connCtx, conn, refresh)
+	}()
+	// 읽기 루프가 끝난 뒤에 반환해야 종료할 때 작업이 더 추가되지 않는다.
+	defer func() {
+		cancel()
+		<-done
 	}()
 
-	retry := 0
 	for {
-		if ctx.Err() != nil {
-			slog.Info("stopping read loop")
-			return
+		select {
+		case <-done:
+			return nil
+		case <-refresh:
+			b.setState(id, StateDraining)
+			next, err := b.connect(ctx)
+			if err != nil {
+				// 새로운 연결에 실패하면 기존 연결이 끊어질 때까지 사용한 뒤 다시 연결한다.
+				slog.Warn("failed to open new connection before refresh", slog.Any("error", err))
+				continue
+			}
+			return next
 		}
+	}
+}
 
-		if retry >= 3 {
-			cancel()
-			slog.Error("failed to read after retrying 3 times, exiting")
-			return
-		}
+func (b *Bot) runReadLoop(ctx context.Context, conn *websocket.Conn, refresh chan<- struct{}) {
+	s := &socket{Conn: conn}
+	defer s.pending.Wait()
+
+	_ = conn.SetReadDeadline(time.Now().Add(pingTimeout))
+	defaultHandler := conn.PingHandler()
+	conn.SetPingHandler(func(appData string) error {
+		_ = conn.SetReadDeadline(time.Now().Add(pingTimeout))
+		return defaultHandler(appData)
+	})
 
+	for {
 		_, data, err := conn.ReadMessage()
 		if err != nil {
-			retry += 1
-			slog.Error("failed to read websocket", slog.Any("error", err))
-			time.Sleep(time.Second * 1)
-			continue
+			if ctx.Err() != nil {
+				slog.Info("stopping read loop")
+			} else {
+				slog.Error("failed to read websocket", slog.Any("error", err))
+			}
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(pingTimeout))
+
+		if b.recorder != nil {
+			if err := b.recorder.Record(data); err != nil {
+				slog.Error("failed to record envelope", slog.Any("error", err))
+			}
 		}
-		retry = 0
 
 		event, err := slack.UnmarshalSlackEvent(data)
 		if err != nil {
@@ -127,54 +524,224 @@ func (b *Bot) runReadLoop(ctx context.Context, conn *websocket.Conn) {
 			continue
 		}
 
-		b.eventCh <- event
+		b.handleEvent(s, event, refresh)
 	}
 }
 
-func (b *Bot) runEventLoop(ctx context.Context, conn *websocket.Conn) {
-	for {
-		select {
-		case <-ctx.Done():
-			slog.Info("stopping event loop")
-			return
-		case event := <-b.eventCh:
-			switch event.EventType() {
-			case slack.EventTypeHello:
-				e := event.(*slack.HelloEvent)
-				slog.Info("receive hello event", slog.Int("count", e.ConnCount))
-			case slack.EventTypeDisconnect:
-				e := event.(*slack.DisconnectEvent)
-				slog.Info("receive disconnect event", slog.String("reason", e.Reason))
-				select {
-				case b.reconnectCh <- struct{}{}:
-				default:
-					slog.Warn("reconnectCh is full, skipping reconnect signal")
-				}
-			case slack.EventTypeSlashCommand:
-				e := event.(*slack.SlashCommandEvent)
-				// event.AcceptsResponsePayload 값에 따라 socket으로 응답을 할 수도 있지만,
-				// 각각 로직을 따로 구분하면 복잡성이 증가하므로 핸들러가 처리하도록 로직을 통일한다.
-				go b.handler.HandleCommandEvent(&e.Payload)
-
-				response := map[string]any{
-					"envelope_id": e.EnvelopeID,
-				}
-				if err := conn.WriteJSON(response); err != nil {
-					slog.Error("failed to command response", slog.Any("error", err))
-				}
-			case slack.EventTypeInteractive:
-				e := event.(*slack.InteractiveEvent)
-				// event.AcceptsResponsePayload 값에 따라 socket으로 응답을 할 수도 있지만,
-				// 각각 로직을 따로 구분하면 복잡성이 증가하므로 핸들러가 처리하도록 로직을 통일한다.
-				go b.handler.HandleInteractiveEvent(&e.Payload)
-
-				response := map[string]any{
-					"envelope_id": e.EnvelopeID,
-				}
-				if err := conn.WriteJSON(response); err != nil {
-					slog.Error("failed to interactive response", slog.Any("error", err))
+func (b *Bot) handleEvent(s *socket, event slack.SlackEvent, refresh chan<- struct{}) {
+	switch event.EventType() {
+	case slack.EventTypeHello:
+		e := event.(*slack.HelloEvent)
+		slog.Info("receive hello event", slog.Int("count", e.ConnCount))
+	case slack.EventTypeDisconnect:
+		e := event.(*slack.DisconnectEvent)
+		slog.Info("receive disconnect event", slog.String("reason", e.Reason))
+		switch e.Reason {
+		case "refresh_requested", "warning":
+			// 곧 연결이 끊어지므로 이벤트를 놓치지 않도록 새로운 연결을 미리 맺는다.
+			select {
+			case refresh <- struct{}{}:
+			default:
+			}
+		default:
+			_ = s.Close()
+		}
+	case slack.EventTypeSlashCommand:
+		e := event.(*slack.SlashCommandEvent)
+		b.handleCommandEnvelope(&envelopeAck{socket: s, envelopeID: e.EnvelopeID}, e)
+	case slack.EventTypeInteractive:
+		e := event.(*slack.InteractiveEvent)
+		b.handleInteractiveEnvelope(&envelopeAck{socket: s, envelopeID: e.EnvelopeID}, e)
+	case slack.EventTypeEventsAPI:
+		e := event.(*slack.EventsAPIEvent)
+		b.handleEventsAPIEnvelope(&envelopeAck{socket: s, envelopeID: e.EnvelopeID}, e)
+	}
+}
+
+func (b *Bot) handleCommandEnvelope(a acknowledger, e *slack.SlashCommandEvent) {
+	commandJob := job{
+		kind:        EventKindCommand,
+		responseURL: e.Payload.ResponseURL,
+		handle: func(ctx context.Context) {
+			b.handle(ctx, &Event{Kind: EventKindCommand, Command: &e.Payload, client: b.client})
+		},
+	}
+
+	// 재전송된 envelope 도 응답은 해야 슬랙이 다시 보내지 않는다.
+	duplicated := !b.dedup.firstSeen(e.EnvelopeID)
+	b.observeEnvelope(EventKindCommand, duplicated)
+	if duplicated {
+		slog.Info("skip duplicated envelope", slog.String("envelope_id", e.EnvelopeID))
+		if err := a.ack(nil); err != nil {
+			slog.Error("failed to command response", slog.Any("error", err))
+		}
+		return
+	}
+
+	h, ok := b.handler.(CommandAckHandler)
+	if !ok || !e.AcceptsResponsePayload {
+		// 핸들러가 오래 걸리더라도 슬랙이 재전송하지 않도록 먼저 응답한다.
+		if err := a.ack(nil); err != nil {
+			slog.Error("failed to command response", slog.Any("error", err))
+		}
+		b.dispatch(commandJob)
+		return
+	}
+
+	// 응답할 메시지를 만드는 동안 다른 envelope 을 계속 읽을 수 있도록 따로 처리한다.
+	a.goAck(func() {
+		ackWith(b, a, func(ctx context.Context) *slack.InteractiveResponsePayload {
+			return commandAckPayload(h.AckCommandEvent(ctx, &e.Payload))
+		})
+		b.dispatch(commandJob)
+	})
+}
+
+func (b *Bot) handleInteractiveEnvelope(a acknowledger, e *slack.InteractiveEvent) {
+	interactiveJob := job{
+		kind:        EventKindInteractive,
+		responseURL: e.Payload.ResponseURL,
+		handle: func(ctx context.Context) {
+			b.handle(ctx, &Event{Kind: EventKindInteractive, Interactive: &e.Payload, client: b.client})
+		},
+	}
+	if interactiveJob.responseURL == "" && e.Payload.Type == slack.InteractionTypeViewSubmission {
+		// 모달 제출에는 response_url 이 없으므로 제출한 사용자에게 DM 으로 알린다.
+		interactiveJob.noticeChannel = e.Payload.User.ID
+	}
+
+	duplicated := !b.dedup.firstSeen(e.EnvelopeID)
+	b.observeEnvelope(EventKindInteractive, duplicated)
+	if duplicated {
+		slog.Info("skip duplicated envelope", slog.String("envelope_id", e.EnvelopeID))
+		if err := a.ack(nil); err != nil {
+			slog.Error("failed to interactive response", slog.Any("error", err))
+		}
+		return
+	}
+
+	suggestion, isSuggestion := b.handler.(BlockSuggestionHandler)
+	isSuggestion = isSuggestion && e.Payload.Type == slack.InteractionTypeBlockSuggestion
+	submission, isSubmission := b.handler.(ViewSubmissionAckHandler)
+	isSubmission = isSubmission && e.Payload.Type == slack.InteractionTypeViewSubmission
+
+	switch {
+	case isSuggestion && e.AcceptsResponsePayload:
+		// 옵션 목록은 응답으로만 전달할 수 있으므로 다른 핸들러로 전달하지 않는다.
+		a.goAck(func() {
+			ackWith(b, a, func(ctx context.Context) *slack.BlockSuggestionResponse {
+				return suggestion.HandleBlockSuggestion(ctx, &e.Payload)
+			})
+		})
+	case isSubmission && e.AcceptsResponsePayload:
+		a.goAck(func() {
+			resp := ackWith(b, a, func(ctx context.Context) *slack.ViewSubmissionResponse {
+				resp := submission.AckViewSubmission(ctx, &e.Payload)
+				if resp != nil && resp.View != nil {
+					if err := resp.View.Validate(); err != nil {
+						slog.Error("invalid view submission ack payload", slog.Any("error", err))
+						return nil
+					}
 				}
+				return resp
+			})
+			if resp != nil && resp.ResponseAction == slack.ViewResponseActionErrors {
+				return
 			}
+			b.dispatch(interactiveJob)
+		})
+	default:
+		// 핸들러가 오래 걸리더라도 슬랙이 재전송하지 않도록 먼저 응답한다.
+		if err := a.ack(nil); err != nil {
+			slog.Error("failed to interactive response", slog.Any("error", err))
+		}
+		b.dispatch(interactiveJob)
+	}
+}
+
+func (b *Bot) handleEventsAPIEnvelope(a acknowledger, e *slack.EventsAPIEvent) {
+	// Events API 이벤트는 핸들러 구현 여부와 관계없이 응답해야 슬랙이 재전송하지 않는다.
+	if err := a.ack(nil); err != nil {
+		slog.Error("failed to events api response", slog.Any("error", err))
+	}
+
+	// 재전송된 이벤트는 envelope_id 가 달라질 수 있으므로 event_id 로도 확인한다.
+	duplicated := !b.dedup.firstSeen(e.EnvelopeID) ||
+		(e.Payload.EventID != "" && !b.dedup.firstSeen("event:"+e.Payload.EventID))
+	b.observeEnvelope(EventKindEventsAPI, duplicated)
+	if duplicated {
+		slog.Info("skip duplicated envelope",
+			slog.String("envelope_id", e.EnvelopeID),
+			slog.String("event_id", e.Payload.EventID),
+			slog.Int("retry_attempt", e.RetryAttempt),
+			slog.String("retry_reason", e.RetryReason),
+		)
+		return
+	}
+	if _, ok := b.handler.(EventsAPIHandler); ok {
+		eventsJob := job{
+			kind: EventKindEventsAPI,
+			handle: func(ctx context.Context) {
+				b.handle(ctx, &Event{Kind: EventKindEventsAPI, EventsAPI: &e.Payload, client: b.client})
+			},
+		}
+		eventsJob.noticeChannel, eventsJob.noticeThread = eventNoticeTarget(&e.Payload)
+		b.dispatch(eventsJob)
+	}
+}
+
+// 작업을 버릴 때 사용자에게 알릴 채널과 스레드를 반환한다.
+// 사용자가 봇에게 보낸 멘션과 DM 에만 알리고, 그 외의 이벤트는 빈 문자열을 반환한다.
+func eventNoticeTarget(payload *slack.EventsAPIPayload) (channel string, thread string) {
+	switch e := payload.Event.(type) {
+	case *slack.AppMentionEvent:
+		thread = e.ThreadTimestamp
+		if thread == "" {
+			thread = e.Timestamp
 		}
+		return e.Channel, thread
+	case *slack.MessageEvent:
+		if !e.IsDirectMessage() || e.BotID != "" || e.SubType != "" {
+			return "", ""
+		}
+		return e.Channel, e.ThreadTimestamp
+	}
+	return "", ""
+}
+
+func (b *Bot) observeEnvelope(kind EventKind, duplicated bool) {
+	if b.observe != nil {
+		b.observe(kind, duplicated)
+	}
+}
+
+// 미들웨어를 모두 거친 이벤트를 종류에 맞는 핸들러로 전달한다.
+func (b *Bot) callHandler(ctx context.Context, e *Event) {
+	switch e.Kind {
+	case EventKindCommand:
+		b.handler.HandleCommandEvent(ctx, e.Command)
+	case EventKindInteractive:
+		b.handleInteractiveEvent(ctx, e.Interactive)
+	case EventKindEventsAPI:
+		if h, ok := b.handler.(EventsAPIHandler); ok {
+			h.HandleEventsAPIEvent(ctx, e.EventsAPI)
+		}
+	}
+}
+
+func (b *Bot) handleInteractiveEvent(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	view, isView := b.handler.(ViewHandler)
+	shortcut, isShortcut := b.handler.(ShortcutHandler)
+	switch {
+	case isView && payload.Type == slack.InteractionTypeViewSubmission:
+		view.HandleViewSubmission(ctx, payload)
+	case isView && payload.Type == slack.InteractionTypeViewClosed:
+		view.HandleViewClosed(ctx, payload)
+	case isShortcut && payload.Type == slack.InteractionTypeShortcut:
+		shortcut.HandleShortcut(ctx, payload)
+	case isShortcut && payload.Type == slack.InteractionTypeMessageAction:
+		shortcut.HandleMessageShortcut(ctx, payload)
+	default:
+		b.handler.HandleInteractiveEvent(ctx, payload)
 	}
 }
@@ -0,0 +1,770 @@
+package bot_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+type recordingHandler struct {
+	commands    chan *slack.SlashCommandEventPayload
+	interactive chan *slack.InteractiveEventPayload
+	events      chan *slack.EventsAPIPayload
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{
+		commands:    make(chan *slack.SlashCommandEventPayload, 1),
+		interactive: make(chan *slack.InteractiveEventPayload, 1),
+		events:      make(chan *slack.EventsAPIPayload, 1),
+	}
+}
+
+func (h *recordingHandler) HandleCommandEvent(_ context.Context, payload *slack.SlashCommandEventPayload) {
+	h.commands <- payload
+}
+
+func (h *recordingHandler) HandleInteractiveEvent(_ context.Context, payload *slack.InteractiveEventPayload) {
+	h.interactive <- payload
+}
+
+func (h *recordingHandler) HandleEventsAPIEvent(_ context.Context, payload *slack.EventsAPIPayload) {
+	h.events <- payload
+}
+
+func runBot(t *testing.T, server *slacktest.Server, handler bot.EventHandler) context.Context {
+	t.Helper()
+
+	ctx, _ := startBot(t, server, handler)
+	if err := server.WaitForConnections(ctx, 1); err != nil {
+		t.Fatalf("bot did not connect: %v", err)
+	}
+	return ctx
+}
+
+func startBot(t *testing.T, server *slacktest.Server, handler bot.EventHandler, opts ...bot.Option) (context.Context, *bot.Bot) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+	opts = append([]bot.Option{
+		bot.WithClient(client),
+		bot.WithBackoff(10*time.Millisecond, 50*time.Millisecond),
+	}, opts...)
+	b := bot.NewBot("xapp-test", "xoxb-test", handler, opts...)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := b.Run(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return ctx, b
+}
+
+func waitForState(t *testing.T, ctx context.Context, b *bot.Bot, state bot.ConnectionState) {
+	t.Helper()
+
+	for b.State() != state {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected %q, got %q", state, b.State())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestBotAcksEnvelopes(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handler := newRecordingHandler()
+	ctx := runBot(t, server, handler)
+
+	envelopeID, err := server.SendSlashCommand(slack.SlashCommandEventPayload{
+		Command:   "/자비스",
+		Text:      "날씨",
+		ChannelID: "C1",
+		UserID:    "U1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := server.WaitForAck(ctx, envelopeID); err != nil {
+		t.Fatalf("expected ack: %v", err)
+	}
+	select {
+	case payload := <-handler.commands:
+		if payload.Text != "날씨" || payload.ResponseURL != server.ResponseURL(envelopeID) {
+			t.Errorf("unexpected payload: %+v", payload)
+		}
+	case <-ctx.Done():
+		t.Fatalf("command was not handled")
+	}
+
+	envelopeID, err = server.SendInteractive(slack.InteractiveEventPayload{
+		Type: slack.InteractionTypeBlockActions,
+		Actions: []slack.InteractiveAction{
+			{Type: "button", ActionID: "done"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := server.WaitForAck(ctx, envelopeID); err != nil {
+		t.Fatalf("expected ack: %v", err)
+	}
+	select {
+	case payload := <-handler.interactive:
+		if len(payload.Actions) != 1 || payload.Actions[0].ActionID != "done" {
+			t.Errorf("unexpected payload: %+v", payload)
+		}
+	case <-ctx.Done():
+		t.Fatalf("interactive event was not handled")
+	}
+
+	envelopeID, err = server.SendEventsAPI(&slack.AppMentionEvent{
+		Type:    slack.InnerEventTypeAppMention,
+		User:    "U1",
+		Text:    "<@U0SLACKTEST> 안녕",
+		Channel: "C1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := server.WaitForAck(ctx, envelopeID); err != nil {
+		t.Fatalf("expected ack: %v", err)
+	}
+	select {
+	case payload := <-handler.events:
+		if _, ok := payload.Event.(*slack.AppMentionEvent); !ok {
+			t.Errorf("unexpected event: %T", payload.Event)
+		}
+	case <-ctx.Done():
+		t.Fatalf("events api event was not handled")
+	}
+}
+
+func TestBotReconnectsOnDisconnect(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	ctx := runBot(t, server, newRecordingHandler())
+
+	if err := server.SendDisconnect("refresh_requested"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := server.WaitForRequests(ctx, "apps.connections.open", 2); err != nil {
+		t.Fatalf("bot did not reconnect: %v", err)
+	}
+	// 새로운 연결을 맺은 뒤에 기존 연결을 닫아야 한다.
+	for server.PeakConnections() != 2 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected new connection before closing old one, peak connections: %d", server.PeakConnections())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	for server.Connections() != 1 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected old connection to be closed, connections: %d", server.Connections())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestBotRetriesConnectFailure(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.SetError("apps.connections.open", "internal_error")
+	ctx, b := startBot(t, server, newRecordingHandler(), bot.WithBackoff(200*time.Millisecond, time.Second))
+
+	// apps.connections.open 은 Tier 1 이므로 버스트를 넘지 않도록 두 번만 실패시킨다.
+	if err := server.WaitForRequests(ctx, "apps.connections.open", 2); err != nil {
+		t.Fatalf("bot did not retry: %v", err)
+	}
+	if state := b.State(); state != bot.StateConnecting {
+		t.Errorf("expected %q, got %q", bot.StateConnecting, state)
+	}
+
+	server.SetError("apps.connections.open", "")
+	if err := server.WaitForConnections(ctx, 1); err != nil {
+		t.Fatalf("bot did not connect: %v", err)
+	}
+	waitForState(t, ctx, b, bot.StateConnected)
+}
+
+func TestBotStopsOnInvalidAuth(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.SetError("apps.connections.open", "invalid_auth")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+	b := bot.NewBot("xapp-test", "xoxb-test", newRecordingHandler(), bot.WithClient(client))
+
+	err := b.Run(ctx)
+	if !errors.Is(err, slack.ErrInvalidAuth) {
+		t.Errorf("expected invalid_auth, got %v", err)
+	}
+	if state := b.State(); state != bot.StateClosed {
+		t.Errorf("expected %q, got %q", bot.StateClosed, state)
+	}
+}
+
+func TestBotSkipsDuplicatedEnvelopes(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handler := newRecordingHandler()
+	ctx, b := startBot(t, server, handler, bot.WithConnections(2))
+
+	if err := server.WaitForConnections(ctx, 2); err != nil {
+		t.Fatalf("bot did not open connections: %v", err)
+	}
+	waitForState(t, ctx, b, bot.StateConnected)
+
+	command := &slack.SlashCommandEvent{
+		Type:       slack.EventTypeSlashCommand,
+		EnvelopeID: "envelope-command",
+		Payload: slack.SlashCommandEventPayload{
+			Command: "/자비스",
+			Text:    "날씨",
+		},
+	}
+	mention := func(envelopeID string) *slack.EventsAPIEvent {
+		return &slack.EventsAPIEvent{
+			Type:       slack.EventTypeEventsAPI,
+			EnvelopeID: envelopeID,
+			Payload: slack.EventsAPIPayload{
+				Type:    "event_callback",
+				EventID: "Ev-mention",
+				Event: &slack.AppMentionEvent{
+					Type: slack.InnerEventTypeAppMention,
+					Text: "<@U0SLACKTEST> 안녕",
+				},
+			},
+		}
+	}
+	retried := mention("envelope-retry")
+	retried.RetryAttempt = 1
+	retried.RetryReason = "timeout"
+
+	testCases := []struct {
+		desc   string
+		events []any
+		wait   func() error
+	}{
+		{
+			desc:   "same envelope is handled once",
+			events: []any{command, command},
+			wait: func() error {
+				select {
+				case <-handler.commands:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			},
+		},
+		{
+			desc:   "retried event is handled once",
+			events: []any{mention("envelope-mention"), retried},
+			wait: func() error {
+				select {
+				case <-handler.events:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			before := len(server.Acks())
+			for _, event := range tc.events {
+				if err := server.Send(event); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if err := tc.wait(); err != nil {
+				t.Fatalf("event was not handled: %v", err)
+			}
+
+			// 중복된 envelope 에도 응답해야 슬랙이 다시 보내지 않는다.
+			for len(server.Acks())-before < len(tc.events) {
+				select {
+				case <-ctx.Done():
+					t.Fatalf("expected %d acks, got %d", len(tc.events), len(server.Acks())-before)
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+
+			select {
+			case <-handler.commands:
+				t.Errorf("duplicated command was handled")
+			case <-handler.events:
+				t.Errorf("duplicated event was handled")
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
+
+type funcHandler struct {
+	command func(ctx context.Context, payload *slack.SlashCommandEventPayload)
+}
+
+func (h *funcHandler) HandleCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) {
+	h.command(ctx, payload)
+}
+
+func (h *funcHandler) HandleInteractiveEvent(context.Context, *slack.InteractiveEventPayload) {}
+
+// Events API 이벤트도 처리하는 funcHandler.
+type funcEventsHandler struct {
+	funcHandler
+	events func(ctx context.Context, payload *slack.EventsAPIPayload)
+}
+
+func (h *funcEventsHandler) HandleEventsAPIEvent(ctx context.Context, payload *slack.EventsAPIPayload) {
+	h.events(ctx, payload)
+}
+
+func TestBotRecoversHandlerPanic(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handled := make(chan string, 1)
+	handler := &funcHandler{
+		command: func(_ context.Context, payload *slack.SlashCommandEventPayload) {
+			if payload.Text == "panic" {
+				panic("boom")
+			}
+			handled <- payload.Text
+		},
+	}
+	ctx := runBot(t, server, handler)
+
+	envelopeID, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "panic"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := server.WaitForResponse(ctx, envelopeID)
+	if err != nil {
+		t.Fatalf("expected error message: %v", err)
+	}
+	if resp.Payload.ResponseType != slack.Ephemeral || resp.Payload.Text == "" {
+		t.Errorf("unexpected response: %+v", resp.Payload)
+	}
+
+	// 패닉이 발생한 뒤에도 이벤트를 계속 처리해야 한다.
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "날씨"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case text := <-handled:
+		if text != "날씨" {
+			t.Errorf("unexpected command: %s", text)
+		}
+	case <-ctx.Done():
+		t.Fatalf("command was not handled after panic")
+	}
+}
+
+func TestBotDropsJobsWhenBusy(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	handled := make(chan string, 2)
+	handler := &funcHandler{
+		command: func(_ context.Context, payload *slack.SlashCommandEventPayload) {
+			started <- struct{}{}
+			<-release
+			handled <- payload.Text
+		},
+	}
+	dropped := make(chan bot.EventKind, 1)
+	ctx, _ := startBot(t, server, handler,
+		bot.WithWorkers(1),
+		bot.WithQueueSize(1),
+		bot.WithDropObserver(func(kind bot.EventKind) { dropped <- kind }),
+	)
+	if err := server.WaitForConnections(ctx, 1); err != nil {
+		t.Fatalf("bot did not connect: %v", err)
+	}
+
+	// 첫 번째 커맨드는 워커가 처리하고, 두 번째 커맨드는 대기열에서 기다린다.
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "첫째"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case <-started:
+	case <-ctx.Done():
+		t.Fatalf("handler was not started")
+	}
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "둘째"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 대기열도 가득 차면 기다리지 않고 버린 뒤 사용자에게 알린다.
+	envelopeID, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "셋째"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := server.WaitForResponse(ctx, envelopeID)
+	if err != nil {
+		t.Fatalf("expected busy message: %v", err)
+	}
+	if resp.Payload.ResponseType != slack.Ephemeral || resp.Payload.Text == "" {
+		t.Errorf("unexpected response: %+v", resp.Payload)
+	}
+	select {
+	case kind := <-dropped:
+		if kind != bot.EventKindCommand {
+			t.Errorf("expected %q, got %q", bot.EventKindCommand, kind)
+		}
+	case <-ctx.Done():
+		t.Fatalf("drop was not observed")
+	}
+
+	close(release)
+	for _, want := range []string{"첫째", "둘째"} {
+		select {
+		case text := <-handled:
+			if text != want {
+				t.Errorf("expected %q, got %q", want, text)
+			}
+		case <-ctx.Done():
+			t.Fatalf("%q was not handled", want)
+		}
+	}
+	select {
+	case text := <-handled:
+		t.Errorf("dropped command was handled: %q", text)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBotNotifiesDroppedDirectMessage(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "D1", IsMember: true})
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+	handled := make(chan string, 1)
+	handler := &funcEventsHandler{
+		funcHandler: funcHandler{
+			command: func(context.Context, *slack.SlashCommandEventPayload) {
+				started <- struct{}{}
+				<-release
+			},
+		},
+		events: func(_ context.Context, payload *slack.EventsAPIPayload) {
+			handled <- payload.EventID
+		},
+	}
+	dropped := make(chan bot.EventKind, 1)
+	ctx, _ := startBot(t, server, handler,
+		bot.WithWorkers(1),
+		bot.WithQueueSize(0),
+		bot.WithDropObserver(func(kind bot.EventKind) { dropped <- kind }),
+	)
+	if err := server.WaitForConnections(ctx, 1); err != nil {
+		t.Fatalf("bot did not connect: %v", err)
+	}
+
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "날씨"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case <-started:
+	case <-ctx.Done():
+		t.Fatalf("handler was not started")
+	}
+
+	// response_url 이 없는 DM 은 대화 채널에 다시 시도해 달라고 알린다.
+	_, err := server.SendEventsAPI(&slack.MessageEvent{
+		Type:        slack.InnerEventTypeMessage,
+		User:        "U1",
+		Text:        "날씨",
+		Channel:     "D1",
+		ChannelType: "im",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	messages, err := server.WaitForMessages(ctx, "D1", 1)
+	if err != nil {
+		t.Fatalf("expected busy message: %v", err)
+	}
+	if messages[0].Text == "" {
+		t.Errorf("unexpected message: %+v", messages[0])
+	}
+	select {
+	case kind := <-dropped:
+		if kind != bot.EventKindEventsAPI {
+			t.Errorf("expected %q, got %q", bot.EventKindEventsAPI, kind)
+		}
+	case <-ctx.Done():
+		t.Fatalf("drop was not observed")
+	}
+	select {
+	case id := <-handled:
+		t.Errorf("dropped event was handled: %q", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBotHandlerTimeout(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	result := make(chan error, 1)
+	handler := &funcHandler{
+		command: func(ctx context.Context, _ *slack.SlashCommandEventPayload) {
+			<-ctx.Done()
+			result <- ctx.Err()
+		},
+	}
+	ctx, _ := startBot(t, server, handler, bot.WithHandlerTimeout(50*time.Millisecond))
+	if err := server.WaitForConnections(ctx, 1); err != nil {
+		t.Fatalf("bot did not connect: %v", err)
+	}
+
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case err := <-result:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected deadline exceeded, got %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatalf("handler was not timed out")
+	}
+}
+
+func TestBotDrainsHandlersOnShutdown(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		work         time.Duration
+		drainTimeout time.Duration
+		expected     error
+	}{
+		{
+			desc:         "in-flight handler finishes",
+			work:         200 * time.Millisecond,
+			drainTimeout: 5 * time.Second,
+			expected:     nil,
+		},
+		{
+			desc:         "handler is canceled after drain timeout",
+			work:         5 * time.Second,
+			drainTimeout: 100 * time.Millisecond,
+			expected:     context.Canceled,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := slacktest.NewServer()
+			defer server.Close()
+			started := make(chan struct{})
+			result := make(chan error, 1)
+			handler := &funcHandler{
+				command: func(ctx context.Context, _ *slack.SlashCommandEventPayload) {
+					close(started)
+					select {
+					case <-time.After(tc.work):
+						result <- nil
+					case <-ctx.Done():
+						result <- ctx.Err()
+					}
+				},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+			b := bot.NewBot("xapp-test", "xoxb-test", handler,
+				bot.WithClient(client),
+				bot.WithDrainTimeout(tc.drainTimeout),
+			)
+			runCtx, stop := context.WithCancel(ctx)
+			done := make(chan error, 1)
+			go func() { done <- b.Run(runCtx) }()
+
+			if err := server.WaitForConnections(ctx, 1); err != nil {
+				t.Fatalf("bot did not connect: %v", err)
+			}
+			if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			select {
+			case <-started:
+			case <-ctx.Done():
+				t.Fatalf("handler was not started")
+			}
+
+			stop()
+			if err := <-done; err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			select {
+			case err := <-result:
+				if !errors.Is(err, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, err)
+				}
+			case <-ctx.Done():
+				t.Fatalf("handler did not finish")
+			}
+		})
+	}
+}
+
+type ackingHandler struct {
+	*recordingHandler
+	submissions chan *slack.InteractiveEventPayload
+}
+
+func (h *ackingHandler) AckCommandEvent(_ context.Context, payload *slack.SlashCommandEventPayload) *slack.InteractiveResponsePayload {
+	return &slack.InteractiveResponsePayload{
+		Blocks: blockkit.Blocks{
+			blockkit.NewHeaderBlock(payload.Text + " 확인중"),
+		},
+	}
+}
+
+func (h *ackingHandler) AckViewSubmission(_ context.Context, payload *slack.InteractiveEventPayload) *slack.ViewSubmissionResponse {
+	if payload.View.CallbackID != "invalid" {
+		return nil
+	}
+	return &slack.ViewSubmissionResponse{
+		ResponseAction: slack.ViewResponseActionErrors,
+		Errors:         map[string]string{"region": "지역을 찾을 수 없습니다."},
+	}
+}
+
+func (h *ackingHandler) HandleBlockSuggestion(_ context.Context, payload *slack.InteractiveEventPayload) *slack.BlockSuggestionResponse {
+	return &slack.BlockSuggestionResponse{
+		Options: []blockkit.OptionBlockObject{
+			{
+				Text:  blockkit.TextObject{Type: blockkit.TextTypePlainText, Text: payload.Value + "시"},
+				Value: payload.Value,
+			},
+		},
+	}
+}
+
+func (h *ackingHandler) HandleViewSubmission(_ context.Context, payload *slack.InteractiveEventPayload) {
+	h.submissions <- payload
+}
+
+func (h *ackingHandler) HandleViewClosed(context.Context, *slack.InteractiveEventPayload) {}
+
+func TestBotAcksWithPayload(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handler := &ackingHandler{
+		recordingHandler: newRecordingHandler(),
+		submissions:      make(chan *slack.InteractiveEventPayload, 1),
+	}
+	ctx := runBot(t, server, handler)
+
+	testCases := []struct {
+		desc     string
+		send     func() (string, error)
+		expected string
+		handled  <-chan *slack.InteractiveEventPayload
+	}{
+		{
+			desc: "command",
+			send: func() (string, error) {
+				return server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "날씨"})
+			},
+			expected: `{"text":"*날씨 확인중*","blocks":[{"text":{"type":"plain_text","text":"날씨 확인중","emoji":true},"type":"header"}],"delete_original":false,"replace_original":false}`,
+		},
+		{
+			desc: "view submission errors",
+			send: func() (string, error) {
+				return server.SendInteractive(slack.InteractiveEventPayload{
+					Type: slack.InteractionTypeViewSubmission,
+					View: &slack.ViewObject{CallbackID: "invalid"},
+				})
+			},
+			expected: `{"response_action":"errors","errors":{"region":"지역을 찾을 수 없습니다."}}`,
+		},
+		{
+			desc: "view submission accepted",
+			send: func() (string, error) {
+				return server.SendInteractive(slack.InteractiveEventPayload{
+					Type: slack.InteractionTypeViewSubmission,
+					View: &slack.ViewObject{CallbackID: "valid"},
+				})
+			},
+			expected: ``,
+			handled:  handler.submissions,
+		},
+		{
+			desc: "block suggestion",
+			send: func() (string, error) {
+				return server.SendInteractive(slack.InteractiveEventPayload{
+					Type:     slack.InteractionTypeBlockSuggestion,
+					ActionID: "region",
+					Value:    "서울",
+				})
+			},
+			expected: `{"options":[{"text":{"type":"plain_text","text":"서울시"},"value":"서울"}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			envelopeID, err := tc.send()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ack, err := server.WaitForAck(ctx, envelopeID)
+			if err != nil {
+				t.Fatalf("expected ack: %v", err)
+			}
+			if string(ack.Payload) != tc.expected {
+				t.Errorf("expected payload %s, got %s", tc.expected, ack.Payload)
+			}
+
+			if tc.handled != nil {
+				select {
+				case <-tc.handled:
+				case <-ctx.Done():
+					t.Fatalf("event was not handled")
+				}
+			}
+		})
+	}
+
+	// 오류를 응답한 모달 제출은 핸들러로 전달하지 않는다.
+	select {
+	case payload := <-handler.submissions:
+		t.Errorf("unexpected submission: %+v", payload.View)
+	default:
+	}
+	// 커맨드는 응답한 뒤에도 핸들러로 전달한다.
+	select {
+	case <-handler.commands:
+	case <-ctx.Done():
+		t.Fatalf("command was not handled")
+	}
+}
@@ -0,0 +1,52 @@
+package bot
+
+import (
+	"sync"
+	"time"
+)
+
+// 일정 시간동안 처리한 envelope 을 기억해서 재전송된 envelope 을 걸러낸다.
+type dedup struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	lastPrune time.Time
+}
+
+func newDedup(window time.Duration) *dedup {
+	return &dedup{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// key 를 처음 보았다면 기록하고 true 를 반환한다.
+// window 안에 이미 본 key 라면 false 를 반환한다.
+func (d *dedup) firstSeen(key string) bool {
+	if key == "" || d.window <= 0 {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	// 오래된 기록이 쌓이지 않도록 window 마다 한 번씩 정리한다.
+	if now.Sub(d.lastPrune) >= d.window {
+		for k, t := range d.seen {
+			if now.Sub(t) >= d.window {
+				delete(d.seen, k)
+			}
+		}
+		d.lastPrune = now
+	}
+
+	if t, ok := d.seen[key]; ok && now.Sub(t) < d.window {
+		return false
+	}
+	d.seen[key] = now
+	return true
+}
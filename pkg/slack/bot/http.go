@@ -0,0 +1,263 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// reference
+// https://api.slack.com/apis/events-api#handshake
+// https://api.slack.com/interactivity/handling#payloads
+
+// 슬랙이 보내는 요청은 이보다 작으므로 더 큰 요청은 거절한다.
+const maxRequestBodySize = 1 << 20
+
+var errNoSigningSecret = errors.New("bot: signing secret is required to receive http requests")
+
+// HTTP 모드 요청에 대한 응답.
+type httpAck struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+func (h *httpAck) ack(payload any) error {
+	if h.written {
+		return nil
+	}
+	h.written = true
+
+	if payload == nil {
+		h.w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	h.w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(h.w).Encode(payload)
+}
+
+// 응답은 요청을 처리하는 고루틴에서 보내야 하므로 바로 실행한다.
+func (h *httpAck) goAck(fn func()) {
+	fn()
+}
+
+// 소켓 모드 대신 슬랙이 HTTP 로 보내는 슬래시 커맨드, 상호작용, Events API 요청을 listener 로 전달받아 처리한다.
+// 여러 인스턴스가 로드 밸런서 뒤에서 요청을 나누어 처리할 수 있다.
+// ctx 가 취소되면 새로운 요청을 받지 않고, 처리중인 핸들러가 끝날 때까지 drainTimeout 만큼 기다린다.
+func (b *Bot) RunHTTP(ctx context.Context, listener net.Listener) error {
+	if b.signingSecret == "" {
+		return errNoSigningSecret
+	}
+
+	stop := b.startWorkers(ctx)
+	defer stop()
+
+	b.resetStates(1, StateConnected)
+	defer b.resetStates(0, StateClosed)
+
+	gate := &requestGate{}
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !gate.enter() {
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			}
+			defer gate.leave()
+			b.serveHTTP(w, r)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	var err error
+	select {
+	case err = <-serveErr:
+	case <-ctx.Done():
+	}
+
+	// Serve 가 실패한 경우에도 이미 요청을 처리하고 있는 핸들러가 있을 수 있으므로 언제나 서버를 종료한다.
+	b.setState(0, StateDraining)
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), b.drainTimeout)
+	defer cancel()
+	if shutdownErr := server.Shutdown(shutdownCtx); shutdownErr != nil {
+		slog.Warn("failed to shutdown http server, closing connections", slog.Any("error", shutdownErr))
+		_ = server.Close()
+	}
+	// Close 는 핸들러가 끝날 때까지 기다리지 않으므로 처리중인 핸들러를 drainTimeout 만큼 더 기다린다.
+	// 그 뒤에도 끝나지 않은 핸들러가 보내는 작업은 작업 채널이 닫혀 있으므로 버려진다.
+	if !gate.close(b.drainTimeout) {
+		slog.Warn("http handlers did not finish before drain timeout", slog.Duration("timeout", b.drainTimeout))
+	}
+
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// 처리중인 HTTP 요청을 추적한다. 닫은 뒤에 들어온 요청은 처리하지 않는다.
+type requestGate struct {
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup
+}
+
+func (g *requestGate) enter() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return false
+	}
+	g.inflight.Add(1)
+	return true
+}
+
+func (g *requestGate) leave() {
+	g.inflight.Done()
+}
+
+// 새로운 요청을 막고 처리중인 요청이 끝날 때까지 timeout 만큼 기다린다.
+// 시간 안에 끝나지 않으면 false 를 반환한다.
+func (g *requestGate) close(timeout time.Duration) bool {
+	g.mu.Lock()
+	g.closed = true
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.inflight.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+func (b *Bot) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// 서명을 확인하기 전에는 본문을 해석하지 않는다.
+	if err := slack.VerifySignature(b.signingSecret, r.Header, body, time.Now()); err != nil {
+		slog.Warn("rejected unsigned request", slog.String("remote", r.RemoteAddr), slog.Any("error", err))
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		b.serveEventsAPI(w, r, body)
+	case "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch {
+		case form.Has("payload"):
+			b.serveInteractive(w, form.Get("payload"))
+		case form.Has("command"):
+			b.serveCommand(w, form)
+		default:
+			http.Error(w, "unknown request", http.StatusBadRequest)
+		}
+	default:
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+	}
+}
+
+func (b *Bot) serveCommand(w http.ResponseWriter, form url.Values) {
+	event := &slack.SlashCommandEvent{
+		Type: slack.EventTypeSlashCommand,
+		// HTTP 응답 본문에는 언제나 메시지를 담을 수 있다.
+		AcceptsResponsePayload: true,
+		Payload: slack.SlashCommandEventPayload{
+			AppID:        form.Get("api_app_id"),
+			UserID:       form.Get("user_id"),
+			Command:      form.Get("command"),
+			Text:         form.Get("text"),
+			ResponseURL:  form.Get("response_url"),
+			TriggerID:    form.Get("trigger_id"),
+			TeamID:       form.Get("team_id"),
+			ChannelID:    form.Get("channel_id"),
+			EnterpriseID: form.Get("enterprise_id"),
+		},
+	}
+	b.handleCommandEnvelope(&httpAck{w: w}, event)
+}
+
+func (b *Bot) serveInteractive(w http.ResponseWriter, data string) {
+	event := &slack.InteractiveEvent{
+		Type: slack.EventTypeInteractive,
+	}
+	if err := json.Unmarshal([]byte(data), &event.Payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event.AcceptsResponsePayload = event.Payload.Type == slack.InteractionTypeViewSubmission ||
+		event.Payload.Type == slack.InteractionTypeBlockSuggestion
+
+	b.handleInteractiveEnvelope(&httpAck{w: w}, event)
+}
+
+func (b *Bot) serveEventsAPI(w http.ResponseWriter, r *http.Request, body []byte) {
+	callback := struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}{}
+	if err := json.Unmarshal(body, &callback); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch callback.Type {
+	case "url_verification":
+		// Request URL 을 등록할 때 전달받은 challenge 를 그대로 응답해야 한다.
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"challenge": callback.Challenge})
+	case "event_callback":
+		event := &slack.EventsAPIEvent{
+			Type:        slack.EventTypeEventsAPI,
+			RetryReason: r.Header.Get("X-Slack-Retry-Reason"),
+		}
+		event.RetryAttempt, _ = strconv.Atoi(r.Header.Get("X-Slack-Retry-Num"))
+		if err := json.Unmarshal(body, &event.Payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		b.handleEventsAPIEnvelope(&httpAck{w: w}, event)
+	default:
+		// app_rate_limited 등 처리할 필요가 없는 콜백.
+		slog.Info("ignore events api callback", slog.String("type", callback.Type))
+		w.WriteHeader(http.StatusOK)
+	}
+}
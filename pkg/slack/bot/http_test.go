@@ -0,0 +1,396 @@
+package bot_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+)
+
+const signingSecret = "test-signing-secret"
+
+func runHTTPBot(t *testing.T, handler bot.EventHandler) (context.Context, *bot.Bot, string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	b := bot.NewBot("", "xoxb-test", handler, bot.WithSigningSecret(signingSecret))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := b.RunHTTP(ctx, listener); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return ctx, b, "http://" + listener.Addr().String()
+}
+
+func post(t *testing.T, url string, contentType string, body string, timestamp time.Time, secret string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(slack.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(slack.HeaderSignature, slack.ComputeSignature(secret, timestamp.Unix(), []byte(body)))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp.StatusCode, strings.TrimSpace(string(data))
+}
+
+func TestBotHTTP(t *testing.T) {
+	handler := &ackingHandler{
+		recordingHandler: newRecordingHandler(),
+		submissions:      make(chan *slack.InteractiveEventPayload, 1),
+	}
+	ctx, b, addr := runHTTPBot(t, handler)
+	waitForState(t, ctx, b, bot.StateConnected)
+
+	const (
+		form = "application/x-www-form-urlencoded"
+		json = "application/json"
+	)
+	command := url.Values{
+		"command":      {"/자비스"},
+		"text":         {"날씨"},
+		"user_id":      {"U1"},
+		"channel_id":   {"C1"},
+		"response_url": {"https://hooks.slack.com/commands/T1/1/abc"},
+	}.Encode()
+	suggestion := url.Values{
+		"payload": {`{"type":"block_suggestion","action_id":"region","value":"서울"}`},
+	}.Encode()
+	mention := `{"type":"event_callback","event_id":"Ev1","event":{"type":"app_mention","user":"U1","text":"<@U0SLACKTEST> 안녕","channel":"C1","ts":"1700000000.000100"}}`
+
+	testCases := []struct {
+		desc         string
+		contentType  string
+		body         string
+		timestamp    time.Time
+		secret       string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			desc:         "url verification",
+			contentType:  json,
+			body:         `{"type":"url_verification","challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P"}`,
+			timestamp:    time.Now(),
+			secret:       signingSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P"}`,
+		},
+		{
+			desc:         "invalid signature",
+			contentType:  form,
+			body:         command,
+			timestamp:    time.Now(),
+			secret:       "wrong-secret",
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: "Unauthorized",
+		},
+		{
+			desc:         "replayed request",
+			contentType:  form,
+			body:         command,
+			timestamp:    time.Now().Add(-10 * time.Minute),
+			secret:       signingSecret,
+			expectedCode: http.StatusUnauthorized,
+			expectedBody: "Unauthorized",
+		},
+		{
+			desc:         "command",
+			contentType:  form,
+			body:         command,
+			timestamp:    time.Now(),
+			secret:       signingSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"text":"*날씨 확인중*","blocks":[{"text":{"type":"plain_text","text":"날씨 확인중","emoji":true},"type":"header"}],"delete_original":false,"replace_original":false}`,
+		},
+		{
+			desc:         "block suggestion",
+			contentType:  form,
+			body:         suggestion,
+			timestamp:    time.Now(),
+			secret:       signingSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: `{"options":[{"text":{"type":"plain_text","text":"서울시"},"value":"서울"}]}`,
+		},
+		{
+			desc:         "events api",
+			contentType:  json,
+			body:         mention,
+			timestamp:    time.Now(),
+			secret:       signingSecret,
+			expectedCode: http.StatusOK,
+			expectedBody: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			code, body := post(t, addr, tc.contentType, tc.body, tc.timestamp, tc.secret)
+			if code != tc.expectedCode {
+				t.Errorf("expected status %d, got %d", tc.expectedCode, code)
+			}
+			if body != tc.expectedBody {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, body)
+			}
+		})
+	}
+
+	select {
+	case payload := <-handler.commands:
+		if payload.Text != "날씨" || payload.ChannelID != "C1" || payload.UserID != "U1" {
+			t.Errorf("unexpected command: %+v", payload)
+		}
+	case <-ctx.Done():
+		t.Fatalf("command was not handled")
+	}
+	select {
+	case payload := <-handler.events:
+		if _, ok := payload.Event.(*slack.AppMentionEvent); !ok {
+			t.Errorf("unexpected event: %T", payload.Event)
+		}
+	case <-ctx.Done():
+		t.Fatalf("event was not handled")
+	}
+
+	// 재전송된 이벤트에도 응답하지만 핸들러로 전달하지 않는다.
+	if code, _ := post(t, addr, json, mention, time.Now(), signingSecret); code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, code)
+	}
+	select {
+	case <-handler.events:
+		t.Errorf("retried event was handled")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// 본문의 마지막 바이트를 남겨두고 요청을 보낸다. 남은 바이트를 보내기 전까지 핸들러는 처리중인 상태로 남는다.
+func sendPartialRequest(t *testing.T, addr string, body string) net.Conn {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	now := time.Now().Unix()
+	header := "POST / HTTP/1.1\r\n" +
+		"Host: " + addr + "\r\n" +
+		"Content-Type: application/x-www-form-urlencoded\r\n" +
+		"Content-Length: " + strconv.Itoa(len(body)) + "\r\n" +
+		slack.HeaderTimestamp + ": " + strconv.FormatInt(now, 10) + "\r\n" +
+		slack.HeaderSignature + ": " + slack.ComputeSignature(signingSecret, now, []byte(body)) + "\r\n\r\n"
+	if _, err := io.WriteString(conn, header+body[:len(body)-1]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return conn
+}
+
+func TestBotHTTPShutdownWithSlowRequest(t *testing.T) {
+	command := url.Values{
+		"command":      {"/자비스"},
+		"text":         {"날씨"},
+		"response_url": {"https://hooks.slack.com/commands/T1/1/abc"},
+	}.Encode()
+
+	testCases := []struct {
+		desc         string
+		drainTimeout time.Duration
+		// 종료를 시작한 뒤 본문의 나머지를 보낼 때까지의 시간. 0 이면 봇이 종료된 뒤에 보낸다.
+		finishAfter time.Duration
+		wantHandled bool
+	}{
+		{desc: "finished before drain timeout", drainTimeout: 2 * time.Second, finishAfter: 100 * time.Millisecond, wantHandled: true},
+		{desc: "unfinished after drain timeout", drainTimeout: 100 * time.Millisecond, wantHandled: false},
+	}
+
+	// net/http 는 핸들러의 패닉을 복구하고 기록만 하므로 기록된 로그로 패닉을 확인한다.
+	var logs syncBuffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			handler := newRecordingHandler()
+			b := bot.NewBot("", "xoxb-test", handler,
+				bot.WithSigningSecret(signingSecret),
+				bot.WithDrainTimeout(tc.drainTimeout),
+			)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			done := make(chan error, 1)
+			go func() {
+				done <- b.RunHTTP(ctx, listener)
+			}()
+			waitCtx, waitCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer waitCancel()
+			waitForState(t, waitCtx, b, bot.StateConnected)
+
+			conn := sendPartialRequest(t, listener.Addr().String(), command)
+			// 요청이 핸들러에 전달될 때까지 기다린 뒤에 종료를 시작한다.
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+
+			if tc.finishAfter > 0 {
+				time.Sleep(tc.finishAfter)
+				if _, err := io.WriteString(conn, command[len(command)-1:]); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			case <-waitCtx.Done():
+				t.Fatalf("bot did not stop")
+			}
+			if tc.finishAfter == 0 {
+				// drainTimeout 이 지나도 끝나지 않은 요청의 연결은 닫혀야 한다.
+				_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+				if _, err := conn.Read(make([]byte, 1)); !errors.Is(err, io.EOF) {
+					t.Errorf("expected connection to be closed, got %v", err)
+				}
+				// 봇이 종료된 뒤에 요청을 마저 보내도 닫힌 작업 채널로 작업을 보내지 않아야 한다.
+				_, _ = io.WriteString(conn, command[len(command)-1:])
+				time.Sleep(100 * time.Millisecond)
+				if strings.Contains(logs.String(), "panic") {
+					t.Errorf("handler panicked: %s", logs.String())
+				}
+			}
+
+			select {
+			case <-handler.commands:
+				if !tc.wantHandled {
+					t.Errorf("unexpected command after shutdown")
+				}
+			default:
+				if tc.wantHandled {
+					t.Errorf("command was not handled")
+				}
+			}
+		})
+	}
+}
+
+// 응답할 메시지를 만드는 동안 release 가 닫힐 때까지 ctx 와 관계없이 멈추는 핸들러.
+type stuckAckHandler struct {
+	*recordingHandler
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (h *stuckAckHandler) AckCommandEvent(context.Context, *slack.SlashCommandEventPayload) *slack.InteractiveResponsePayload {
+	close(h.entered)
+	<-h.release
+	return nil
+}
+
+func TestBotHTTPShutdownWithStuckHandler(t *testing.T) {
+	command := url.Values{
+		"command":      {"/자비스"},
+		"text":         {"날씨"},
+		"response_url": {"https://hooks.slack.com/commands/T1/1/abc"},
+	}.Encode()
+
+	var logs syncBuffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handler := &stuckAckHandler{
+		recordingHandler: newRecordingHandler(),
+		entered:          make(chan struct{}),
+		release:          make(chan struct{}),
+	}
+	b := bot.NewBot("", "xoxb-test", handler,
+		bot.WithSigningSecret(signingSecret),
+		bot.WithDrainTimeout(100*time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- b.RunHTTP(ctx, listener)
+	}()
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer waitCancel()
+	waitForState(t, waitCtx, b, bot.StateConnected)
+
+	conn := sendPartialRequest(t, listener.Addr().String(), command)
+	if _, err := io.WriteString(conn, command[len(command)-1:]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case <-handler.entered:
+	case <-waitCtx.Done():
+		t.Fatalf("handler was not started")
+	}
+
+	// 끝나지 않는 핸들러가 있어도 drainTimeout 이 지나면 종료해야 한다.
+	start := time.Now()
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-waitCtx.Done():
+		t.Fatalf("bot did not stop")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected bounded shutdown, took %v", elapsed)
+	}
+
+	// 종료한 뒤에 끝난 핸들러의 작업은 닫힌 작업 채널로 보내지 않고 버린다.
+	close(handler.release)
+	time.Sleep(100 * time.Millisecond)
+	if strings.Contains(logs.String(), "panic") {
+		t.Errorf("handler panicked: %s", logs.String())
+	}
+	select {
+	case <-handler.commands:
+		t.Errorf("unexpected command after shutdown")
+	default:
+	}
+}
@@ -0,0 +1,313 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// 미들웨어가 다루는 이벤트 종류.
+type EventKind string
+
+const (
+	EventKindCommand     EventKind = "command"
+	EventKindInteractive EventKind = "interactive"
+	EventKindEventsAPI   EventKind = "events_api"
+)
+
+// 미들웨어를 거쳐 핸들러로 전달되는 이벤트. 종류에 해당하는 페이로드 하나만 채워진다.
+type Event struct {
+	Kind        EventKind
+	Command     *slack.SlashCommandEventPayload
+	Interactive *slack.InteractiveEventPayload
+	EventsAPI   *slack.EventsAPIPayload
+
+	// response_url 로 응답할 때 사용한다.
+	client *slack.Client
+}
+
+// 이벤트를 구분하는 이름. 커맨드는 슬래시 커맨드, 상호작용은 action_id 나 모달 또는 바로가기의 callback_id,
+// Events API 는 이벤트 타입을 사용한다. 사용자가 입력한 텍스트는 포함하지 않는다.
+func (e *Event) Name() string {
+	switch e.Kind {
+	case EventKindCommand:
+		return e.Command.Command
+	case EventKindInteractive:
+		switch {
+		case len(e.Interactive.Actions) > 0:
+			return e.Interactive.Actions[0].ActionID
+		case e.Interactive.View != nil && e.Interactive.View.CallbackID != "":
+			return e.Interactive.View.CallbackID
+		case e.Interactive.CallbackID != "":
+			return e.Interactive.CallbackID
+		default:
+			return string(e.Interactive.Type)
+		}
+	case EventKindEventsAPI:
+		if e.EventsAPI.Event != nil {
+			return string(e.EventsAPI.Event.InnerEventType())
+		}
+		return e.EventsAPI.Type
+	default:
+		return ""
+	}
+}
+
+// 이벤트를 발생시킨 사용자의 ID. 알 수 없으면 비어있다.
+func (e *Event) UserID() string {
+	switch e.Kind {
+	case EventKindCommand:
+		return e.Command.UserID
+	case EventKindInteractive:
+		return e.Interactive.User.ID
+	case EventKindEventsAPI:
+		switch inner := e.EventsAPI.Event.(type) {
+		case *slack.AppMentionEvent:
+			return inner.User
+		case *slack.MessageEvent:
+			return inner.User
+		case *slack.ReactionAddedEvent:
+			return inner.User
+		case *slack.MemberJoinedChannelEvent:
+			return inner.User
+		}
+	}
+	return ""
+}
+
+// 이벤트가 발생한 채널의 ID. 알 수 없으면 비어있다.
+func (e *Event) ChannelID() string {
+	switch e.Kind {
+	case EventKindCommand:
+		return e.Command.ChannelID
+	case EventKindInteractive:
+		if e.Interactive.Channel.ID != "" {
+			return e.Interactive.Channel.ID
+		}
+		return e.Interactive.Container.ChannelID
+	case EventKindEventsAPI:
+		switch inner := e.EventsAPI.Event.(type) {
+		case *slack.AppMentionEvent:
+			return inner.Channel
+		case *slack.MessageEvent:
+			return inner.Channel
+		case *slack.ReactionAddedEvent:
+			return inner.Item.Channel
+		case *slack.MemberJoinedChannelEvent:
+			return inner.Channel
+		}
+	}
+	return ""
+}
+
+// 이벤트에 응답할 수 있는 response_url. Events API 이벤트는 비어있다.
+func (e *Event) ResponseURL() string {
+	switch e.Kind {
+	case EventKindCommand:
+		return e.Command.ResponseURL
+	case EventKindInteractive:
+		return e.Interactive.ResponseURL
+	default:
+		return ""
+	}
+}
+
+var errNoResponseURL = errors.New("bot: event has no response_url")
+
+// response_url 로 메시지를 보낸다. 미들웨어에서 이벤트를 거절한 이유를 알릴 때 사용한다.
+func (e *Event) Respond(ctx context.Context, payload *slack.InteractiveResponsePayload) error {
+	url := e.ResponseURL()
+	if url == "" || e.client == nil {
+		return errNoResponseURL
+	}
+	return e.client.Respond(ctx, url, payload)
+}
+
+// 로그에 남길 이벤트 정보.
+func (e *Event) attrs() []any {
+	attrs := []any{
+		slog.String("kind", string(e.Kind)),
+		slog.String("name", e.Name()),
+		slog.String("user", e.UserID()),
+		slog.String("channel", e.ChannelID()),
+	}
+	if e.Kind == EventKindCommand {
+		attrs = append(attrs, slog.String("text", e.Command.Text))
+	}
+	return attrs
+}
+
+// 미들웨어로 감쌀 수 있는 이벤트 처리 함수.
+type HandlerFunc func(ctx context.Context, e *Event)
+
+// 다음 처리 함수를 감싸서 공통 작업을 추가한다. next 를 호출하지 않으면 이벤트는 핸들러로 전달되지 않는다.
+// 미들웨어는 워커에서 핸들러를 실행할 때 적용되며, 즉시 응답을 만드는 Ack 핸들러에는 적용되지 않는다.
+// 따라서 Allow, Deny, RateLimit 으로 거절한 커맨드에도 Ack 메시지가 먼저 보일 수 있으며,
+// 거절 메시지는 그 Ack 메시지를 대체한다.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// 미들웨어를 하나로 합친다. 먼저 전달한 미들웨어가 바깥쪽에서 실행된다.
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		for _, middleware := range slices.Backward(middlewares) {
+			next = middleware(next)
+		}
+		return next
+	}
+}
+
+// 이벤트의 종류, 이름, 사용자, 채널과 처리 시간을 로그로 남긴다.
+func Logging() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e *Event) {
+			start := time.Now()
+			next(ctx, e)
+			slog.Info("handled event", append(e.attrs(), slog.Duration("duration", time.Since(start)))...)
+		}
+	}
+}
+
+// 핸들러의 처리 시간을 observe 로 전달한다. 지표를 수집할 때 사용한다.
+func Timing(observe func(e *Event, duration time.Duration)) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e *Event) {
+			start := time.Now()
+			defer func() { observe(e, time.Since(start)) }()
+			next(ctx, e)
+		}
+	}
+}
+
+// 사용자나 채널을 기준으로 이벤트를 허용하거나 거부하는 목록.
+type AccessList struct {
+	Users    []string
+	Channels []string
+}
+
+func (l AccessList) empty() bool {
+	return len(l.Users) == 0 && len(l.Channels) == 0
+}
+
+func (l AccessList) contains(e *Event) bool {
+	return slices.Contains(l.Users, e.UserID()) || slices.Contains(l.Channels, e.ChannelID())
+}
+
+// 거절한 이벤트에 보여줄 메시지.
+const (
+	accessDeniedMessage = "이 기능을 사용할 수 있는 권한이 없습니다."
+	rateLimitedMessage  = "요청이 너무 많습니다. 잠시 후 다시 시도해 주세요."
+)
+
+// 목록에 포함된 사용자나 채널의 이벤트만 처리한다. 목록이 비어있으면 모두 처리한다.
+func Allow(list AccessList) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e *Event) {
+			if !list.empty() && !list.contains(e) {
+				reject(ctx, e, "not allowed", accessDeniedMessage)
+				return
+			}
+			next(ctx, e)
+		}
+	}
+}
+
+// 목록에 포함된 사용자나 채널의 이벤트는 처리하지 않는다.
+func Deny(list AccessList) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e *Event) {
+			if list.contains(e) {
+				reject(ctx, e, "denied", accessDeniedMessage)
+				return
+			}
+			next(ctx, e)
+		}
+	}
+}
+
+// 사용자마다 per 동안 limit 개의 이벤트까지 처리하고, 넘는 이벤트는 버린다.
+// 짧은 시간에 몰린 요청도 limit 개까지는 처리하며, 이후에는 per 동안 고르게 다시 허용한다.
+// 사용자를 알 수 없는 이벤트는 제한하지 않는다.
+func RateLimit(limit int, per time.Duration) Middleware {
+	limiter := &rateLimiter{
+		limit:   float64(max(limit, 1)),
+		per:     per,
+		buckets: make(map[string]*bucket),
+	}
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e *Event) {
+			if user := e.UserID(); user != "" && !limiter.allow(user, time.Now()) {
+				reject(ctx, e, "rate limited", rateLimitedMessage)
+				return
+			}
+			next(ctx, e)
+		}
+	}
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type rateLimiter struct {
+	limit float64
+	per   time.Duration
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 다시 가득 찬 버킷은 새로 만든 것과 같으므로 주기적으로 정리한다.
+	if now.Sub(l.lastPrune) >= l.per {
+		for k, b := range l.buckets {
+			if now.Sub(b.updated) >= l.per {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.limit, updated: now}
+		l.buckets[key] = b
+	}
+	if l.per > 0 {
+		b.tokens = min(l.limit, b.tokens+l.limit*float64(now.Sub(b.updated))/float64(l.per))
+	}
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// 이벤트를 처리하지 않고, 응답할 수 있으면 사용자에게만 보이는 메시지로 이유를 알린다.
+// 커맨드는 미들웨어보다 먼저 보낸 Ack 메시지(e.g. 진행중 메시지)가 남지 않도록 대체한다.
+// 상호작용은 원본이 다른 사용자에게도 보이는 메시지일 수 있으므로 대체하지 않는다.
+func reject(ctx context.Context, e *Event, reason string, message string) {
+	slog.Warn("rejected event", append(e.attrs(), slog.String("reason", reason))...)
+	if e.ResponseURL() == "" {
+		return
+	}
+	err := e.Respond(ctx, &slack.InteractiveResponsePayload{
+		ResponseType:    slack.Ephemeral,
+		Text:            message,
+		ReplaceOriginal: e.Kind == EventKindCommand,
+	})
+	if err != nil {
+		slog.Error("failed to respond rejected event", slog.Any("error", err))
+	}
+}
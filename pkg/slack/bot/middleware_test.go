@@ -0,0 +1,203 @@
+package bot_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func commandEvent(user, channel string) *bot.Event {
+	return &bot.Event{
+		Kind:    bot.EventKindCommand,
+		Command: &slack.SlashCommandEventPayload{Command: "/자비스", UserID: user, ChannelID: channel},
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	trace := func(name string) bot.Middleware {
+		return func(next bot.HandlerFunc) bot.HandlerFunc {
+			return func(ctx context.Context, e *bot.Event) {
+				calls = append(calls, name+" before")
+				next(ctx, e)
+				calls = append(calls, name+" after")
+			}
+		}
+	}
+
+	handle := bot.Chain(trace("outer"), trace("inner"))(func(context.Context, *bot.Event) {
+		calls = append(calls, "handler")
+	})
+	handle(context.Background(), commandEvent("U1", "C1"))
+
+	want := []string{"outer before", "inner before", "handler", "inner after", "outer after"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("expected %q, got %q", want, calls)
+	}
+}
+
+func TestAccessList(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		middleware bot.Middleware
+		event      *bot.Event
+		handled    bool
+	}{
+		{desc: "empty allow list", middleware: bot.Allow(bot.AccessList{}), event: commandEvent("U1", "C1"), handled: true},
+		{desc: "allowed user", middleware: bot.Allow(bot.AccessList{Users: []string{"U1"}}), event: commandEvent("U1", "C1"), handled: true},
+		{desc: "allowed channel", middleware: bot.Allow(bot.AccessList{Channels: []string{"C1"}}), event: commandEvent("U2", "C1"), handled: true},
+		{desc: "not allowed", middleware: bot.Allow(bot.AccessList{Users: []string{"U1"}}), event: commandEvent("U2", "C2")},
+		{desc: "denied user", middleware: bot.Deny(bot.AccessList{Users: []string{"U1"}}), event: commandEvent("U1", "C1")},
+		{desc: "denied channel", middleware: bot.Deny(bot.AccessList{Channels: []string{"C1"}}), event: commandEvent("U2", "C1")},
+		{desc: "not denied", middleware: bot.Deny(bot.AccessList{Users: []string{"U1"}}), event: commandEvent("U2", "C2"), handled: true},
+		{
+			desc:       "events api user",
+			middleware: bot.Deny(bot.AccessList{Users: []string{"U1"}}),
+			event: &bot.Event{
+				Kind:      bot.EventKindEventsAPI,
+				EventsAPI: &slack.EventsAPIPayload{Event: &slack.AppMentionEvent{User: "U1", Channel: "C2"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handled := false
+			tc.middleware(func(context.Context, *bot.Event) { handled = true })(context.Background(), tc.event)
+			if handled != tc.handled {
+				t.Errorf("expected handled=%t, got %t", tc.handled, handled)
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	handled := map[string]int{}
+	handle := bot.RateLimit(2, 100*time.Millisecond)(func(_ context.Context, e *bot.Event) {
+		handled[e.UserID()]++
+	})
+
+	ctx := context.Background()
+	for range 3 {
+		handle(ctx, commandEvent("U1", "C1"))
+	}
+	handle(ctx, commandEvent("U2", "C1"))
+	// 사용자를 알 수 없는 이벤트는 제한하지 않는다.
+	for range 3 {
+		handle(ctx, commandEvent("", "C1"))
+	}
+	if handled["U1"] != 2 || handled["U2"] != 1 || handled[""] != 3 {
+		t.Fatalf("unexpected handled events: %v", handled)
+	}
+
+	// 시간이 지나면 다시 허용한다.
+	time.Sleep(60 * time.Millisecond)
+	handle(ctx, commandEvent("U1", "C1"))
+	if handled["U1"] != 3 {
+		t.Errorf("expected event to be handled after refill, got %v", handled)
+	}
+}
+
+func TestTiming(t *testing.T) {
+	var observed []string
+	handle := bot.Timing(func(e *bot.Event, d time.Duration) {
+		if d < 10*time.Millisecond {
+			t.Errorf("unexpected duration: %v", d)
+		}
+		observed = append(observed, string(e.Kind)+":"+e.Name())
+	})(func(context.Context, *bot.Event) {
+		time.Sleep(10 * time.Millisecond)
+	})
+	handle(context.Background(), commandEvent("U1", "C1"))
+
+	if !slices.Equal(observed, []string{"command:/자비스"}) {
+		t.Errorf("unexpected observed events: %q", observed)
+	}
+}
+
+func TestBotMiddleware(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handled := make(chan string, 1)
+	handler := &funcHandler{
+		command: func(_ context.Context, payload *slack.SlashCommandEventPayload) {
+			handled <- payload.UserID
+		},
+	}
+	ctx, b := startBot(t, server, handler,
+		bot.WithMiddleware(bot.Logging(), bot.Deny(bot.AccessList{Users: []string{"U_DENIED"}})),
+	)
+	waitForState(t, ctx, b, bot.StateConnected)
+
+	// 거절한 이벤트는 핸들러로 전달하지 않고 response_url 로 이유를 알린다.
+	// 미들웨어보다 먼저 보낸 Ack 메시지가 남지 않도록 대체한다.
+	envelopeID, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", UserID: "U_DENIED"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := server.WaitForResponse(ctx, envelopeID)
+	if err != nil {
+		t.Fatalf("expected rejection message: %v", err)
+	}
+	if resp.Payload.ResponseType != slack.Ephemeral || resp.Payload.Text == "" || !resp.Payload.ReplaceOriginal {
+		t.Errorf("unexpected response: %+v", resp.Payload)
+	}
+
+	if _, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", UserID: "U_ALLOWED"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case user := <-handled:
+		if user != "U_ALLOWED" {
+			t.Errorf("unexpected user: %s", user)
+		}
+	case <-ctx.Done():
+		t.Fatalf("command was not handled")
+	}
+}
+
+func TestBotEnvelopeObserver(t *testing.T) {
+	file, err := os.Open("testdata/incident.jsonl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var (
+		observed []string
+		handled  []string
+	)
+	handler := &funcHandler{
+		command: func(_ context.Context, payload *slack.SlashCommandEventPayload) {
+			handled = append(handled, payload.Text)
+		},
+	}
+	b := bot.NewBot("xapp-test", "xoxb-test", handler,
+		bot.WithWorkers(1),
+		bot.WithEnvelopeObserver(func(kind bot.EventKind, duplicated bool) {
+			observed = append(observed, fmt.Sprintf("%s:%t", kind, duplicated))
+		}),
+		bot.WithMiddleware(bot.Timing(func(e *bot.Event, _ time.Duration) {
+			handled = append(handled, "timing:"+string(e.Kind))
+		})),
+	)
+	if _, err := b.Replay(context.Background(), file, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 중복된 envelope 도 받은 것으로 기록하지만 핸들러로 전달하지는 않는다.
+	want := []string{"command:false", "command:true", "events_api:false"}
+	if !slices.Equal(observed, want) {
+		t.Errorf("expected %q, got %q", want, observed)
+	}
+	if !slices.Equal(handled, []string{"날씨", "timing:command"}) {
+		t.Errorf("unexpected handled events: %q", handled)
+	}
+}
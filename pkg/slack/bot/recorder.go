@@ -0,0 +1,121 @@
+package bot
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 기록 파일의 한 줄.
+type Record struct {
+	// envelope 을 받은 시간.
+	Time time.Time `json:"time"`
+	// 민감한 값을 가린 envelope 원본.
+	Envelope json.RawMessage `json:"envelope"`
+}
+
+// 기록할 때 값을 가리는 키. 토큰과 일회용 주소, 사용자 이름과 연락처를 가리고,
+// 재현에 필요한 ID 와 텍스트는 그대로 남긴다.
+var redactedKeys = map[string]bool{
+	"token":        true,
+	"response_url": true,
+	"trigger_id":   true,
+	"user_name":    true,
+	"username":     true,
+	"real_name":    true,
+	"display_name": true,
+	"first_name":   true,
+	"last_name":    true,
+	"email":        true,
+	"phone":        true,
+}
+
+// 기록할 때 값을 가리는 경로. 다른 곳에서도 쓰이는 키는 부모 키와 함께 지정한다.
+// (e.g. 상호작용 payload 의 user.name 은 사용자 이름이지만 channel.name 은 채널 이름이다)
+var redactedPaths = map[string]bool{
+	"user.name":         true,
+	"user_profile.name": true,
+}
+
+// 가린 값을 대신하는 문자열.
+const redacted = "[REDACTED]"
+
+// 소켓 모드로 받은 envelope 원본을 한 줄에 하나씩 JSON 으로 기록한다.
+// 기록한 파일은 Bot.Replay 로 다시 처리해 장애를 재현하거나 회귀 테스트의 입력으로 사용한다.
+type Recorder struct {
+	mu sync.Mutex
+	w  io.Writer
+	// 기록하는 시간. 테스트에서 고정할 수 있도록 분리한다.
+	now func() time.Time
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w, now: time.Now}
+}
+
+// envelope 의 민감한 값을 가린 뒤 기록한다.
+// JSON 이 아닌 데이터는 민감한 값을 가릴 수 없으므로 기록하지 않고 길이만 로그로 남긴다.
+func (r *Recorder) Record(data []byte) error {
+	envelope, err := Redact(data)
+	if err != nil {
+		slog.Warn("skip recording non-json envelope", slog.Int("length", len(data)))
+		return nil
+	}
+	line, err := json.Marshal(Record{Time: r.now(), Envelope: envelope})
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err = r.w.Write(append(line, '\n'))
+	return err
+}
+
+// JSON 데이터에서 토큰과 개인정보에 해당하는 값을 가린다. 숫자는 원본 그대로 유지한다.
+func Redact(data []byte) (json.RawMessage, error) {
+	return rewriteJSON(data, func(path []string, value any) (any, bool) {
+		key := path[len(path)-1]
+		if redactedKeys[key] || (len(path) > 1 && redactedPaths[path[len(path)-2]+"."+key]) {
+			if s, ok := value.(string); ok && s == "" {
+				return value, false
+			}
+			return redacted, true
+		}
+		return value, false
+	})
+}
+
+// JSON 의 모든 객체를 순회하며 replace 가 true 를 반환한 키의 값을 바꾼다.
+// replace 에는 최상위 객체부터 해당 키까지의 키 목록을 전달하며, 배열의 인덱스는 포함하지 않는다.
+func rewriteJSON(data []byte, replace func(path []string, value any) (any, bool)) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(rewriteValue(nil, value, replace))
+}
+
+func rewriteValue(path []string, value any, replace func(path []string, value any) (any, bool)) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			childPath := append(path[:len(path):len(path)], key)
+			if replaced, ok := replace(childPath, child); ok {
+				v[key] = replaced
+				continue
+			}
+			v[key] = rewriteValue(childPath, child, replace)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = rewriteValue(path, child, replace)
+		}
+	}
+	return value
+}
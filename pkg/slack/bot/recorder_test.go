@@ -0,0 +1,202 @@
+package bot_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func TestRedact(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		input string
+		want  string
+	}{
+		{
+			desc:  "token and urls",
+			input: `{"token":"secret","response_url":"https://hooks.slack.com/commands/T1/1/abc","trigger_id":"1.2.3","text":"날씨"}`,
+			want:  `{"response_url":"[REDACTED]","text":"날씨","token":"[REDACTED]","trigger_id":"[REDACTED]"}`,
+		},
+		{
+			desc:  "nested user profile",
+			input: `{"user":{"id":"U1","username":"alice","profile":{"real_name":"Alice","email":"alice@example.com"}}}`,
+			want:  `{"user":{"id":"U1","profile":{"email":"[REDACTED]","real_name":"[REDACTED]"},"username":"[REDACTED]"}}`,
+		},
+		{
+			desc:  "block actions user name",
+			input: `{"type":"block_actions","user":{"id":"U1","username":"alice","name":"alice","team_id":"T1"},"channel":{"id":"C1","name":"general"},"actions":[{"action_id":"forecast","user":{"name":"bob"}}]}`,
+			want:  `{"actions":[{"action_id":"forecast","user":{"name":"[REDACTED]"}}],"channel":{"id":"C1","name":"general"},"type":"block_actions","user":{"id":"U1","name":"[REDACTED]","team_id":"T1","username":"[REDACTED]"}}`,
+		},
+		{
+			desc:  "arrays and numbers",
+			input: `{"items":[{"token":"a"},{"token":""}],"event_time":1735776004,"ts":"1735776004.000100"}`,
+			want:  `{"event_time":1735776004,"items":[{"token":"[REDACTED]"},{"token":""}],"ts":"1735776004.000100"}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := bot.Redact([]byte(tc.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecorderSkipsNonJSON(t *testing.T) {
+	var buf bytes.Buffer
+	recorder := bot.NewRecorder(&buf)
+	if err := recorder.Record([]byte(`token=secret&user_name=alice`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing recorded, got %s", buf.String())
+	}
+}
+
+// 봇이 기록하는 동안 읽을 수 있도록 쓰기와 읽기를 직렬화한 버퍼.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestBotRecordsEnvelopes(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	var buf syncBuffer
+	handler := newRecordingHandler()
+	ctx, b := startBot(t, server, handler, bot.WithRecorder(bot.NewRecorder(&buf)))
+	waitForState(t, ctx, b, bot.StateConnected)
+
+	envelopeID, err := server.SendSlashCommand(slack.SlashCommandEventPayload{Command: "/자비스", Text: "날씨", UserID: "U1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := server.WaitForAck(ctx, envelopeID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var command *slack.SlashCommandEvent
+	scanner := bufio.NewScanner(strings.NewReader(buf.String()))
+	for scanner.Scan() {
+		var record bot.Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid record %s: %v", scanner.Text(), err)
+		}
+		if record.Time.IsZero() {
+			t.Errorf("record has no time: %s", scanner.Text())
+		}
+		event, err := slack.UnmarshalSlackEvent(record.Envelope)
+		if err != nil {
+			t.Fatalf("invalid envelope %s: %v", record.Envelope, err)
+		}
+		if e, ok := event.(*slack.SlashCommandEvent); ok {
+			command = e
+		}
+	}
+	if command == nil {
+		t.Fatalf("command envelope was not recorded: %s", buf.String())
+	}
+	if command.EnvelopeID != envelopeID || command.Payload.Text != "날씨" || command.Payload.UserID != "U1" {
+		t.Errorf("unexpected recorded command: %+v", command)
+	}
+	if command.Payload.ResponseURL != "[REDACTED]" || command.Payload.TriggerID != "[REDACTED]" {
+		t.Errorf("response_url and trigger_id should be redacted: %+v", command.Payload)
+	}
+}
+
+func TestBotReplay(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+
+	var (
+		mu       sync.Mutex
+		mentions []string
+	)
+	router := bot.NewRouter()
+	router.Command("날씨", func(ctx context.Context, req *bot.CommandRequest) {
+		err := client.Respond(ctx, req.Payload.ResponseURL, &slack.InteractiveResponsePayload{Text: "맑음"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}).Ack(func(context.Context, *bot.CommandRequest) *slack.InteractiveResponsePayload {
+		return &slack.InteractiveResponsePayload{Text: "확인중"}
+	})
+	handler := &routerWithEvents{
+		Router: router,
+		events: func(payload *slack.EventsAPIPayload) {
+			mu.Lock()
+			defer mu.Unlock()
+			mentions = append(mentions, payload.Event.(*slack.AppMentionEvent).Text)
+		},
+	}
+
+	file, err := os.Open("testdata/incident.jsonl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	b := bot.NewBot("xapp-test", "xoxb-test", handler, bot.WithClient(client))
+	acks, err := b.Replay(context.Background(), file, server.ResponseURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 중복된 envelope 에도 빈 응답을 보내야 한다.
+	var got []string
+	for _, ack := range acks {
+		got = append(got, ack.EnvelopeID+":"+string(ack.Payload))
+	}
+	want := []string{
+		`envelope-1:{"text":"확인중","delete_original":false,"replace_original":false}`,
+		"envelope-1:",
+		"envelope-2:",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected acks %q, got %q", want, got)
+	}
+
+	responses := server.Responses()
+	if len(responses) != 1 || responses[0].ID != "envelope-1" || responses[0].Payload.Text != "맑음" {
+		t.Errorf("unexpected responses: %+v", responses)
+	}
+	if !slices.Equal(mentions, []string{"<@U0JARVIS> 공휴일"}) {
+		t.Errorf("unexpected mentions: %q", mentions)
+	}
+}
+
+type routerWithEvents struct {
+	*bot.Router
+	events func(payload *slack.EventsAPIPayload)
+}
+
+func (r *routerWithEvents) HandleEventsAPIEvent(_ context.Context, payload *slack.EventsAPIPayload) {
+	r.events(payload)
+}
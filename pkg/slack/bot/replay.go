@@ -0,0 +1,128 @@
+package bot
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// 기록 파일 한 줄의 최대 크기.
+const maxRecordSize = 4 << 20
+
+// 다시 처리한 envelope 에 봇이 보낸 응답.
+type ReplayedAck struct {
+	EnvelopeID string
+	// 응답에 담긴 payload. 빈 응답이면 nil 이다.
+	Payload json.RawMessage
+}
+
+// 다시 처리할 때 소켓 대신 응답을 모으는 acknowledger.
+type replayAck struct {
+	mu         *sync.Mutex
+	acks       *[]ReplayedAck
+	envelopeID string
+}
+
+func (r *replayAck) ack(payload any) error {
+	ack := ReplayedAck{EnvelopeID: r.envelopeID}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		ack.Payload = data
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.acks = append(*r.acks, ack)
+	return nil
+}
+
+// 기록된 순서대로 응답을 모을 수 있도록 바로 실행한다.
+func (r *replayAck) goAck(fn func()) {
+	fn()
+}
+
+// Recorder 로 기록한 envelope 을 순서대로 다시 처리하고, 모든 핸들러가 끝날 때까지 기다린다.
+// 기록에서 가려진 response_url 은 responseURL(envelope_id) 로 바꿔서 전달하므로,
+// slacktest.Server.ResponseURL 을 사용하면 핸들러의 응답을 확인할 수 있다.
+// 실제 연결 없이 중복 확인, 미들웨어, 워커를 모두 거치며, 봇이 보낸 응답을 기록된 순서대로 반환한다.
+func (b *Bot) Replay(ctx context.Context, r io.Reader, responseURL func(envelopeID string) string) ([]ReplayedAck, error) {
+	var (
+		mu   sync.Mutex
+		acks []ReplayedAck
+	)
+	newAck := func(envelopeID string) *replayAck {
+		return &replayAck{mu: &mu, acks: &acks, envelopeID: envelopeID}
+	}
+
+	stop := b.startWorkers(ctx)
+	err := b.replayRecords(ctx, r, responseURL, newAck)
+	// 핸들러가 모두 끝난 뒤에 반환해야 응답을 확인할 수 있다.
+	stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	return acks, err
+}
+
+func (b *Bot) replayRecords(ctx context.Context, r io.Reader, responseURL func(envelopeID string) string, newAck func(envelopeID string) *replayAck) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("bot: invalid record at line %d: %w", line, err)
+		}
+		event, err := replayEvent(record.Envelope, responseURL)
+		if err != nil {
+			slog.Warn("skip unreadable record", slog.Int("line", line), slog.Any("error", err))
+			continue
+		}
+
+		switch e := event.(type) {
+		case *slack.SlashCommandEvent:
+			b.handleCommandEnvelope(newAck(e.EnvelopeID), e)
+		case *slack.InteractiveEvent:
+			b.handleInteractiveEnvelope(newAck(e.EnvelopeID), e)
+		case *slack.EventsAPIEvent:
+			b.handleEventsAPIEnvelope(newAck(e.EnvelopeID), e)
+		}
+	}
+	return scanner.Err()
+}
+
+// 기록된 envelope 의 response_url 을 바꾸고 이벤트로 변환한다.
+func replayEvent(envelope json.RawMessage, responseURL func(envelopeID string) string) (slack.SlackEvent, error) {
+	var header struct {
+		EnvelopeID string `json:"envelope_id"`
+	}
+	if err := json.Unmarshal(envelope, &header); err != nil {
+		return nil, err
+	}
+
+	data, err := rewriteJSON(envelope, func(path []string, value any) (any, bool) {
+		if path[len(path)-1] == "response_url" && responseURL != nil {
+			return responseURL(header.EnvelopeID), true
+		}
+		return value, false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slack.UnmarshalSlackEvent(data)
+}
@@ -0,0 +1,454 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/hangul"
+	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+var (
+	_ EventHandler      = (*Router)(nil)
+	_ ViewHandler       = (*Router)(nil)
+	_ CommandAckHandler = (*Router)(nil)
+	_ ShortcutHandler   = (*Router)(nil)
+)
+
+// 커맨드를 처리하는 함수.
+type CommandFunc func(ctx context.Context, req *CommandRequest)
+
+// 커맨드에 즉시 보여줄 메시지를 만드는 함수. CommandAckHandler 와 같다.
+type CommandAckFunc func(ctx context.Context, req *CommandRequest) *slack.InteractiveResponsePayload
+
+// 블록 액션을 처리하는 함수.
+type ActionFunc func(ctx context.Context, req *ActionRequest)
+
+// 모달 제출 및 닫기 이벤트를 처리하는 함수.
+type ViewFunc func(ctx context.Context, payload *slack.InteractiveEventPayload)
+
+// 전역 바로가기와 메시지 바로가기를 처리하는 함수.
+// 메시지 바로가기는 payload.Message 에 바로가기를 실행한 메시지가 담긴다.
+type ShortcutFunc func(ctx context.Context, payload *slack.InteractiveEventPayload)
+
+// 라우터가 찾은 커맨드 요청.
+type CommandRequest struct {
+	Payload *slack.SlashCommandEventPayload
+	// 일치한 커맨드와 서브커맨드의 이름. 별칭으로 입력해도 등록한 이름이 담긴다.
+	// Default, NotFound 핸들러에서는 비어있다.
+	Path []string
+	// 커맨드와 서브커맨드를 제외한 나머지 인자. (e.g. `/자비스 날씨 서울` 의 "서울")
+	Args Args
+	// 일치하는 커맨드가 없을 때 입력한 단어와 가장 비슷한 커맨드의 이름.
+	// NotFound 핸들러에서 안내 메시지를 만들 때 사용한다.
+	Suggestion string
+	// 멘션이나 DM 으로 전달된 커맨드의 메시지. 슬래시 커맨드에서는 nil 이다.
+	// 메시지로 전달된 커맨드는 response_url 이 없으므로 이 채널에 메시지를 보내 응답한다.
+	Message *CommandMessage
+}
+
+// 멘션이나 DM 으로 커맨드를 전달한 메시지.
+type CommandMessage struct {
+	Channel string
+	// 응답할 스레드의 ts. 비어있으면 채널에 응답한다.
+	ThreadTimestamp string
+}
+
+// 라우터가 찾은 블록 액션 요청.
+type ActionRequest struct {
+	Payload *slack.InteractiveEventPayload
+	Action  slack.InteractiveAction
+	// ActionPattern 으로 등록한 경우 action_id 와 일치한 하위 그룹. 그 외에는 비어있다.
+	Matches []string
+}
+
+// 도움말을 만들 때 사용하는 커맨드 정보.
+type CommandInfo struct {
+	Name    string
+	Aliases []string
+	// 커맨드에 대한 설명.
+	Description string
+	// 커맨드 뒤에 입력하는 인자의 사용법. (e.g. "[지역]")
+	Usage string
+	// 도움말에서 커맨드를 바로 실행하는 버튼의 action_id. 없으면 비어있다.
+	ActionID    string
+	Subcommands []CommandInfo
+}
+
+// 라우터에 등록된 커맨드. 메서드를 이어서 호출해 설명과 별칭 등을 등록한다.
+//
+//	router.Command("날씨", handleForecast).
+//		Alias("weather").
+//		Describe("초단기 날씨 예보").
+//		Usage("[지역]")
+type CommandRoute struct {
+	router      *Router
+	parent      *CommandRoute
+	name        string
+	aliases     []string
+	description string
+	usage       string
+	actionID    string
+	handler     CommandFunc
+	ack         CommandAckFunc
+	subcommands []*CommandRoute
+}
+
+// 커맨드의 별칭을 등록한다.
+func (c *CommandRoute) Alias(names ...string) *CommandRoute {
+	for _, name := range names {
+		c.router.checkCommand(c.siblings(), name)
+		c.aliases = append(c.aliases, name)
+	}
+	return c
+}
+
+// 도움말에 표시할 설명을 등록한다. 설명이 없는 커맨드는 도움말에 표시하지 않는다.
+func (c *CommandRoute) Describe(description string) *CommandRoute {
+	c.description = description
+	return c
+}
+
+// 도움말에 표시할 인자의 사용법을 등록한다.
+func (c *CommandRoute) Usage(usage string) *CommandRoute {
+	c.usage = usage
+	return c
+}
+
+// 도움말에서 커맨드를 바로 실행하는 버튼의 action_id 를 등록한다.
+// 버튼을 눌렀을 때의 동작은 Router.Action 으로 따로 등록한다.
+func (c *CommandRoute) Button(actionID string) *CommandRoute {
+	c.actionID = actionID
+	return c
+}
+
+// 커맨드에 즉시 보여줄 메시지를 만드는 함수를 등록한다.
+// 오래 걸리는 커맨드에서 진행중 메시지를 보여줄 때 사용한다.
+func (c *CommandRoute) Ack(ack CommandAckFunc) *CommandRoute {
+	c.ack = ack
+	return c
+}
+
+// 서브커맨드를 등록한다. (e.g. `/자비스 설정 알림`)
+func (c *CommandRoute) Subcommand(name string, handler CommandFunc) *CommandRoute {
+	c.router.checkCommand(c.subcommands, name)
+	sub := &CommandRoute{router: c.router, parent: c, name: name, handler: handler}
+	c.subcommands = append(c.subcommands, sub)
+	return sub
+}
+
+// 같은 단계에 등록된 커맨드 목록.
+func (c *CommandRoute) siblings() []*CommandRoute {
+	if c.parent != nil {
+		return c.parent.subcommands
+	}
+	return c.router.commands
+}
+
+func (c *CommandRoute) matches(word string) bool {
+	if strings.EqualFold(c.name, word) {
+		return true
+	}
+	return slices.ContainsFunc(c.aliases, func(alias string) bool {
+		return strings.EqualFold(alias, word)
+	})
+}
+
+func (c *CommandRoute) info() CommandInfo {
+	info := CommandInfo{
+		Name:        c.name,
+		Aliases:     slices.Clone(c.aliases),
+		Description: c.description,
+		Usage:       c.usage,
+		ActionID:    c.actionID,
+	}
+	for _, sub := range c.subcommands {
+		if sub.description != "" {
+			info.Subcommands = append(info.Subcommands, sub.info())
+		}
+	}
+	return info
+}
+
+type actionRoute struct {
+	id      string
+	prefix  string
+	pattern *regexp.Regexp
+	handler ActionFunc
+}
+
+// 커맨드 이름, action_id, 모달과 바로가기의 callback_id 로 핸들러를 찾아 실행하는 EventHandler.
+// 하나의 슬래시 커맨드를 사용하며, 커맨드 텍스트의 첫 단어로 커맨드를 구분한다.
+// 핸들러는 봇을 실행하기 전에 모두 등록해야 한다.
+type Router struct {
+	commands []*CommandRoute
+	// 텍스트 없이 슬래시 커맨드만 입력했을 때 실행한다.
+	defaultHandler CommandFunc
+	// 일치하는 커맨드가 없을 때 실행한다.
+	notFoundHandler CommandFunc
+
+	actions        []actionRoute
+	viewSubmission map[string]ViewFunc
+	viewClosed     map[string]ViewFunc
+
+	shortcuts        map[string]ShortcutFunc
+	messageShortcuts map[string]ShortcutFunc
+}
+
+func NewRouter() *Router {
+	return &Router{
+		viewSubmission:   make(map[string]ViewFunc),
+		viewClosed:       make(map[string]ViewFunc),
+		shortcuts:        make(map[string]ShortcutFunc),
+		messageShortcuts: make(map[string]ShortcutFunc),
+	}
+}
+
+// 커맨드를 등록한다. 이름이나 별칭이 이미 등록되어 있으면 패닉이 발생한다.
+func (r *Router) Command(name string, handler CommandFunc) *CommandRoute {
+	r.checkCommand(r.commands, name)
+	route := &CommandRoute{router: r, name: name, handler: handler}
+	r.commands = append(r.commands, route)
+	return route
+}
+
+// 텍스트 없이 슬래시 커맨드만 입력했을 때 실행할 핸들러를 등록한다.
+// 등록하지 않으면 NotFound 핸들러를 실행한다.
+func (r *Router) Default(handler CommandFunc) {
+	r.defaultHandler = handler
+}
+
+// 일치하는 커맨드가 없을 때 실행할 핸들러를 등록한다.
+func (r *Router) NotFound(handler CommandFunc) {
+	r.notFoundHandler = handler
+}
+
+// action_id 가 일치하는 블록 액션의 핸들러를 등록한다.
+func (r *Router) Action(actionID string, handler ActionFunc) {
+	r.actions = append(r.actions, actionRoute{id: actionID, handler: handler})
+}
+
+// action_id 가 prefix 로 시작하는 블록 액션의 핸들러를 등록한다. (e.g. "forecast_")
+func (r *Router) ActionPrefix(prefix string, handler ActionFunc) {
+	r.actions = append(r.actions, actionRoute{prefix: prefix, handler: handler})
+}
+
+// action_id 가 pattern 과 일치하는 블록 액션의 핸들러를 등록한다.
+// 하위 그룹은 ActionRequest.Matches 로 전달된다.
+func (r *Router) ActionPattern(pattern *regexp.Regexp, handler ActionFunc) {
+	r.actions = append(r.actions, actionRoute{pattern: pattern, handler: handler})
+}
+
+// callback_id 가 일치하는 모달 제출 핸들러를 등록한다.
+func (r *Router) ViewSubmission(callbackID string, handler ViewFunc) {
+	r.viewSubmission[callbackID] = handler
+}
+
+// callback_id 가 일치하는 모달 닫기 핸들러를 등록한다.
+func (r *Router) ViewClosed(callbackID string, handler ViewFunc) {
+	r.viewClosed[callbackID] = handler
+}
+
+// callback_id 가 일치하는 전역 바로가기의 핸들러를 등록한다.
+func (r *Router) Shortcut(callbackID string, handler ShortcutFunc) {
+	r.shortcuts[callbackID] = handler
+}
+
+// callback_id 가 일치하는 메시지 바로가기의 핸들러를 등록한다.
+func (r *Router) MessageShortcut(callbackID string, handler ShortcutFunc) {
+	r.messageShortcuts[callbackID] = handler
+}
+
+// 도움말에 표시할 커맨드를 등록한 순서대로 반환한다. 설명이 없는 커맨드는 제외한다.
+func (r *Router) Commands() []CommandInfo {
+	var infos []CommandInfo
+	for _, route := range r.commands {
+		if route.description != "" {
+			infos = append(infos, route.info())
+		}
+	}
+	return infos
+}
+
+func (r *Router) HandleCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) {
+	r.dispatch(ctx, payload, nil)
+}
+
+// 메시지 앞에서 봇을 부른 멘션. (e.g. `<@U0LAN0Z89> 날씨` 의 `<@U0LAN0Z89>`)
+var leadingMentionPattern = regexp.MustCompile(`^\s*<@[A-Z0-9]+(\|[^>]*)?>`)
+
+// 멘션이나 DM 으로 전달된 텍스트를 슬래시 커맨드와 같은 방법으로 찾아 실행한다.
+// 텍스트 맨 앞의 멘션 하나만 봇을 부른 것으로 보고 무시하며, 나머지 멘션은 인자로 전달한다.
+// (e.g. `<@U0LAN0Z89> 휴가 <@U123>` 는 `휴가 <@U123>` 으로 처리한다)
+func (r *Router) Dispatch(ctx context.Context, userID string, message *CommandMessage, text string) {
+	payload := &slack.SlashCommandEventPayload{
+		UserID:    userID,
+		ChannelID: message.Channel,
+		Text:      strings.TrimSpace(leadingMentionPattern.ReplaceAllString(text, "")),
+	}
+	r.dispatch(ctx, payload, message)
+}
+
+func (r *Router) dispatch(ctx context.Context, payload *slack.SlashCommandEventPayload, message *CommandMessage) {
+	route, req := r.matchCommand(payload)
+	req.Message = message
+	switch {
+	case route != nil:
+		route.handler(ctx, req)
+	case len(tokenize(payload.Text)) == 0 && r.defaultHandler != nil:
+		r.defaultHandler(ctx, req)
+	case r.notFoundHandler != nil:
+		r.notFoundHandler(ctx, req)
+	default:
+		slog.Warn("undefined command", slog.String("command", payload.Command), slog.String("text", payload.Text))
+	}
+}
+
+func (r *Router) AckCommandEvent(ctx context.Context, payload *slack.SlashCommandEventPayload) *slack.InteractiveResponsePayload {
+	route, req := r.matchCommand(payload)
+	if route == nil || route.ack == nil {
+		return nil
+	}
+	return route.ack(ctx, req)
+}
+
+func (r *Router) HandleInteractiveEvent(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	if payload.Type != slack.InteractionTypeBlockActions {
+		slog.Warn("undefined interaction type", slog.String("type", string(payload.Type)))
+		return
+	}
+
+	for _, action := range payload.Actions {
+		handler, matches, ok := r.matchAction(action.ActionID)
+		if !ok {
+			slog.Warn("undefined action", slog.String("action_id", action.ActionID))
+			continue
+		}
+		handler(ctx, &ActionRequest{
+			Payload: payload,
+			Action:  action,
+			Matches: matches,
+		})
+	}
+}
+
+func (r *Router) HandleViewSubmission(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	r.handleView(ctx, r.viewSubmission, payload)
+}
+
+func (r *Router) HandleViewClosed(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	r.handleView(ctx, r.viewClosed, payload)
+}
+
+func (r *Router) HandleShortcut(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	r.handleShortcut(ctx, r.shortcuts, payload)
+}
+
+func (r *Router) HandleMessageShortcut(ctx context.Context, payload *slack.InteractiveEventPayload) {
+	r.handleShortcut(ctx, r.messageShortcuts, payload)
+}
+
+func (r *Router) handleShortcut(ctx context.Context, handlers map[string]ShortcutFunc, payload *slack.InteractiveEventPayload) {
+	handler, ok := handlers[payload.CallbackID]
+	if !ok {
+		slog.Warn("undefined shortcut", slog.String("type", string(payload.Type)), slog.String("callback_id", payload.CallbackID))
+		return
+	}
+	handler(ctx, payload)
+}
+
+func (r *Router) handleView(ctx context.Context, handlers map[string]ViewFunc, payload *slack.InteractiveEventPayload) {
+	var callbackID string
+	if payload.View != nil {
+		callbackID = payload.View.CallbackID
+	}
+	handler, ok := handlers[callbackID]
+	if !ok {
+		slog.Warn("undefined view", slog.String("type", string(payload.Type)), slog.String("callback_id", callbackID))
+		return
+	}
+	handler(ctx, payload)
+}
+
+// 텍스트의 앞에서부터 커맨드와 서브커맨드를 찾는다. 일치하는 커맨드가 없으면 nil 을 반환하고,
+// 입력한 첫 단어와 비슷한 커맨드를 CommandRequest.Suggestion 에 담는다.
+func (r *Router) matchCommand(payload *slack.SlashCommandEventPayload) (*CommandRoute, *CommandRequest) {
+	tokens := tokenize(payload.Text)
+	req := &CommandRequest{Payload: payload}
+
+	var matched *CommandRoute
+	routes := r.commands
+	for len(tokens) > 0 && !tokens[0].quoted {
+		i := slices.IndexFunc(routes, func(route *CommandRoute) bool {
+			return route.matches(tokens[0].text)
+		})
+		if i < 0 {
+			break
+		}
+		matched = routes[i]
+		req.Path = append(req.Path, matched.name)
+		tokens = tokens[1:]
+		routes = matched.subcommands
+	}
+	if matched == nil && len(tokens) > 0 {
+		req.Suggestion, _ = r.Suggest(tokens[0].text)
+	}
+	req.Args = parseArgs(tokens, kst.Now())
+	return matched, req
+}
+
+// 입력한 단어와 가장 비슷한 커맨드의 이름을 찾는다. 한글은 자모 단위로 비교하므로
+// "날시" 처럼 자음 하나를 잘못 입력해도 "날씨" 를 찾는다. 비슷한 커맨드가 없으면 false 를 반환한다.
+func (r *Router) Suggest(word string) (string, bool) {
+	// 별칭도 비교하되 제안은 커맨드의 이름으로 한다.
+	var names, commands []string
+	for _, route := range r.commands {
+		for _, name := range append([]string{route.name}, route.aliases...) {
+			names = append(names, strings.ToLower(name))
+			commands = append(commands, route.name)
+		}
+	}
+	i, ok := hangul.Closest(strings.ToLower(word), names)
+	if !ok {
+		return "", false
+	}
+	return commands[i], true
+}
+
+// action_id 와 일치하는 핸들러를 찾는다. 정확히 일치하는 핸들러를 먼저 찾고,
+// 없으면 접두사와 정규식으로 등록한 핸들러를 등록한 순서대로 찾는다.
+func (r *Router) matchAction(actionID string) (ActionFunc, []string, bool) {
+	for _, route := range r.actions {
+		if route.prefix == "" && route.pattern == nil && route.id == actionID {
+			return route.handler, nil, true
+		}
+	}
+	for _, route := range r.actions {
+		switch {
+		case route.prefix != "" && strings.HasPrefix(actionID, route.prefix):
+			return route.handler, nil, true
+		case route.pattern != nil:
+			if matches := route.pattern.FindStringSubmatch(actionID); matches != nil {
+				return route.handler, matches[1:], true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+// 같은 단계의 커맨드 중에 name 과 같은 이름이나 별칭이 있으면 패닉이 발생한다.
+func (r *Router) checkCommand(routes []*CommandRoute, name string) {
+	if len(strings.Fields(name)) != 1 {
+		panic(fmt.Sprintf("bot: invalid command name %q", name))
+	}
+	for _, route := range routes {
+		if route.matches(name) {
+			panic(fmt.Sprintf("bot: command %q is already registered", name))
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package bot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/bot"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func TestRouterCommand(t *testing.T) {
+	var handled string
+	var got *bot.CommandRequest
+	record := func(name string) bot.CommandFunc {
+		return func(_ context.Context, req *bot.CommandRequest) {
+			handled = name
+			got = req
+		}
+	}
+
+	router := bot.NewRouter()
+	router.Default(record("default"))
+	router.NotFound(record("not found"))
+	router.Command("날씨", record("forecast")).Alias("weather")
+	settings := router.Command("설정", record("settings"))
+	settings.Subcommand("알림", record("notification")).Alias("noti")
+
+	testCases := []struct {
+		desc       string
+		text       string
+		handled    string
+		path       []string
+		args       []string
+		suggestion string
+	}{
+		{desc: "empty text", text: "  ", handled: "default"},
+		{desc: "command", text: "날씨", handled: "forecast", path: []string{"날씨"}},
+		{desc: "alias ignores case", text: "Weather", handled: "forecast", path: []string{"날씨"}},
+		{desc: "arguments", text: "날씨  서울 강남", handled: "forecast", path: []string{"날씨"}, args: []string{"서울", "강남"}},
+		{desc: "subcommand", text: "설정 noti 끄기", handled: "notification", path: []string{"설정", "알림"}, args: []string{"끄기"}},
+		{desc: "unknown subcommand is an argument", text: "설정 언어", handled: "settings", path: []string{"설정"}, args: []string{"언어"}},
+		{desc: "quoted argument", text: `날씨 "서울 강남"`, handled: "forecast", path: []string{"날씨"}, args: []string{"서울 강남"}},
+		{desc: "quoted command is an argument", text: `"날씨"`, handled: "not found", args: []string{"날씨"}, suggestion: "날씨"},
+		{desc: "unknown command", text: "점심 메뉴", handled: "not found", args: []string{"점심", "메뉴"}},
+		{desc: "typo", text: "날시 서울", handled: "not found", args: []string{"날시", "서울"}, suggestion: "날씨"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handled, got = "", nil
+			router.HandleCommandEvent(context.Background(), &slack.SlashCommandEventPayload{Text: tc.text})
+
+			if handled != tc.handled {
+				t.Fatalf("expected %q handler, got %q", tc.handled, handled)
+			}
+			if !slices.Equal(got.Path, tc.path) || !slices.Equal(got.Args.Positional, tc.args) {
+				t.Errorf("unexpected request: path=%q args=%q", got.Path, got.Args.Positional)
+			}
+			if got.Suggestion != tc.suggestion {
+				t.Errorf("expected suggestion %q, got %q", tc.suggestion, got.Suggestion)
+			}
+		})
+	}
+}
+
+func TestRouterCommandAck(t *testing.T) {
+	router := bot.NewRouter()
+	router.Command("날씨", func(context.Context, *bot.CommandRequest) {}).
+		Ack(func(context.Context, *bot.CommandRequest) *slack.InteractiveResponsePayload {
+			return &slack.InteractiveResponsePayload{Text: "확인중"}
+		})
+	router.Command("기능", func(context.Context, *bot.CommandRequest) {})
+
+	testCases := []struct {
+		desc string
+		text string
+		want string
+	}{
+		{desc: "command with ack", text: "날씨 서울", want: "확인중"},
+		{desc: "command without ack", text: "기능"},
+		{desc: "unknown command", text: "점심"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp := router.AckCommandEvent(context.Background(), &slack.SlashCommandEventPayload{Text: tc.text})
+			var got string
+			if resp != nil {
+				got = resp.Text
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRouterDispatch(t *testing.T) {
+	var handled string
+	var got *bot.CommandRequest
+	record := func(name string) bot.CommandFunc {
+		return func(_ context.Context, req *bot.CommandRequest) {
+			handled = name
+			got = req
+		}
+	}
+
+	router := bot.NewRouter()
+	router.Default(record("default"))
+	router.NotFound(record("not found"))
+	router.Command("날씨", record("forecast"))
+
+	testCases := []struct {
+		desc       string
+		text       string
+		handled    string
+		args       []string
+		users      []string
+		suggestion string
+	}{
+		{desc: "mention only", text: "<@U0LAN0Z89>", handled: "default"},
+		{desc: "command after mention", text: "<@U0LAN0Z89> 날씨 서울", handled: "forecast", args: []string{"서울"}},
+		{desc: "mention with name", text: "<@U0LAN0Z89|jarvis>  날씨", handled: "forecast"},
+		{desc: "direct message", text: "날씨", handled: "forecast"},
+		{desc: "mention in arguments", text: "<@U0LAN0Z89> 날씨 <@U123>", handled: "forecast", users: []string{"U123"}},
+		{desc: "typo", text: "<@U0LAN0Z89> 날시", handled: "not found", args: []string{"날시"}, suggestion: "날씨"},
+	}
+
+	message := &bot.CommandMessage{Channel: "C1", ThreadTimestamp: "1700000000.000100"}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handled, got = "", nil
+			router.Dispatch(context.Background(), "U1", message, tc.text)
+
+			if handled != tc.handled {
+				t.Fatalf("expected %q handler, got %q", tc.handled, handled)
+			}
+			if got.Message != message || got.Payload.UserID != "U1" || got.Payload.ChannelID != "C1" {
+				t.Errorf("unexpected request: %+v", got)
+			}
+			if !slices.Equal(got.Args.Positional, tc.args) || !slices.Equal(got.Args.Users, tc.users) || got.Suggestion != tc.suggestion {
+				t.Errorf("unexpected request: args=%q users=%q suggestion=%q", got.Args.Positional, got.Args.Users, got.Suggestion)
+			}
+		})
+	}
+
+	// 슬래시 커맨드에는 메시지가 없다.
+	router.HandleCommandEvent(context.Background(), &slack.SlashCommandEventPayload{Text: "날씨"})
+	if got.Message != nil {
+		t.Errorf("expected no message, got %+v", got.Message)
+	}
+}
+
+func TestRouterAction(t *testing.T) {
+	var handled []string
+	var matches []string
+	record := func(name string) bot.ActionFunc {
+		return func(_ context.Context, req *bot.ActionRequest) {
+			handled = append(handled, name+":"+req.Action.ActionID)
+			matches = req.Matches
+		}
+	}
+
+	router := bot.NewRouter()
+	// 정확히 일치하는 액션은 먼저 등록한 접두사보다 우선한다.
+	router.ActionPrefix("forecast_", record("prefix"))
+	router.Action("forecast_region", record("exact"))
+	router.ActionPattern(regexp.MustCompile(`^vote_(\w+)_(\d+)$`), record("pattern"))
+
+	testCases := []struct {
+		desc    string
+		actions []string
+		handled []string
+		matches []string
+	}{
+		{desc: "exact", actions: []string{"forecast_region"}, handled: []string{"exact:forecast_region"}},
+		{desc: "prefix", actions: []string{"forecast_refresh"}, handled: []string{"prefix:forecast_refresh"}},
+		{desc: "pattern", actions: []string{"vote_lunch_3"}, handled: []string{"pattern:vote_lunch_3"}, matches: []string{"lunch", "3"}},
+		{desc: "unknown action is skipped", actions: []string{"unknown", "forecast_region"}, handled: []string{"exact:forecast_region"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			handled, matches = nil, nil
+			payload := &slack.InteractiveEventPayload{Type: slack.InteractionTypeBlockActions}
+			for _, id := range tc.actions {
+				payload.Actions = append(payload.Actions, slack.InteractiveAction{ActionID: id})
+			}
+			router.HandleInteractiveEvent(context.Background(), payload)
+
+			if !slices.Equal(handled, tc.handled) {
+				t.Errorf("expected %q, got %q", tc.handled, handled)
+			}
+			if !slices.Equal(matches, tc.matches) {
+				t.Errorf("expected matches %q, got %q", tc.matches, matches)
+			}
+		})
+	}
+}
+
+func TestRouterView(t *testing.T) {
+	var handled []string
+	router := bot.NewRouter()
+	router.ViewSubmission("feedback", func(context.Context, *slack.InteractiveEventPayload) {
+		handled = append(handled, "submission")
+	})
+	router.ViewClosed("feedback", func(context.Context, *slack.InteractiveEventPayload) {
+		handled = append(handled, "closed")
+	})
+
+	ctx := context.Background()
+	router.HandleViewSubmission(ctx, &slack.InteractiveEventPayload{View: &slack.ViewObject{CallbackID: "feedback"}})
+	router.HandleViewSubmission(ctx, &slack.InteractiveEventPayload{View: &slack.ViewObject{CallbackID: "unknown"}})
+	router.HandleViewClosed(ctx, &slack.InteractiveEventPayload{View: &slack.ViewObject{CallbackID: "feedback"}})
+
+	if !slices.Equal(handled, []string{"submission", "closed"}) {
+		t.Errorf("unexpected handled views: %q", handled)
+	}
+}
+
+func TestRouterShortcut(t *testing.T) {
+	var handled []string
+	router := bot.NewRouter()
+	router.Shortcut("jarvis_menu", func(_ context.Context, payload *slack.InteractiveEventPayload) {
+		handled = append(handled, "menu:"+payload.User.ID)
+	})
+	router.MessageShortcut("remind_message", func(_ context.Context, payload *slack.InteractiveEventPayload) {
+		handled = append(handled, "remind:"+payload.Message.Text)
+	})
+
+	ctx := context.Background()
+	router.HandleShortcut(ctx, &slack.InteractiveEventPayload{CallbackID: "jarvis_menu", User: slack.InteractiveUser{ID: "U1"}})
+	router.HandleShortcut(ctx, &slack.InteractiveEventPayload{CallbackID: "remind_message"})
+	router.HandleMessageShortcut(ctx, &slack.InteractiveEventPayload{CallbackID: "remind_message", Message: &slack.MessageObject{Text: "회의"}})
+	router.HandleMessageShortcut(ctx, &slack.InteractiveEventPayload{CallbackID: "unknown", Message: &slack.MessageObject{}})
+
+	if !slices.Equal(handled, []string{"menu:U1", "remind:회의"}) {
+		t.Errorf("unexpected handled shortcuts: %q", handled)
+	}
+}
+
+func TestBotRoutesShortcuts(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	handled := make(chan string, 2)
+	router := bot.NewRouter()
+	router.Shortcut("jarvis_menu", func(_ context.Context, payload *slack.InteractiveEventPayload) {
+		handled <- "menu:" + payload.TriggerID
+	})
+	router.MessageShortcut("export_thread_summary", func(_ context.Context, payload *slack.InteractiveEventPayload) {
+		handled <- fmt.Sprintf("summary:%s:%s", payload.Channel.ID, payload.ThreadTimestamp())
+	})
+	ctx, b := startBot(t, server, router)
+	waitForState(t, ctx, b, bot.StateConnected)
+
+	payloads := []slack.InteractiveEventPayload{
+		{Type: slack.InteractionTypeShortcut, CallbackID: "jarvis_menu", TriggerID: "trigger-1"},
+		{
+			Type:       slack.InteractionTypeMessageAction,
+			CallbackID: "export_thread_summary",
+			Channel:    slack.InteractiveChannel{ID: "C1"},
+			Message:    &slack.MessageObject{Text: "답글", Timestamp: "1700000100.000200", ThreadTimestamp: "1700000000.000100"},
+		},
+	}
+	for _, payload := range payloads {
+		envelopeID, err := server.SendInteractive(payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := server.WaitForAck(ctx, envelopeID); err != nil {
+			t.Fatalf("shortcut was not acked: %v", err)
+		}
+	}
+	// 스레드의 답글에서 실행하면 부모 메시지의 ts 를 사용한다.
+	want := []string{"menu:trigger-1", "summary:C1:1700000000.000100"}
+	var got []string
+	for range want {
+		select {
+		case h := <-handled:
+			got = append(got, h)
+		case <-ctx.Done():
+			t.Fatalf("shortcut was not handled, got %q", got)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRouterCommands(t *testing.T) {
+	nop := func(context.Context, *bot.CommandRequest) {}
+	router := bot.NewRouter()
+	router.Command("기능", nop)
+	router.Command("날씨", nop).Alias("weather").Describe("초단기 날씨 예보").Usage("[지역]").Button("forecast")
+	settings := router.Command("설정", nop).Describe("설정")
+	settings.Subcommand("알림", nop).Describe("알림 설정")
+	settings.Subcommand("숨김", nop)
+
+	commands := router.Commands()
+	if len(commands) != 2 {
+		t.Fatalf("expected 2 commands, got %+v", commands)
+	}
+	forecast := commands[0]
+	if forecast.Name != "날씨" || forecast.Usage != "[지역]" || forecast.ActionID != "forecast" || !slices.Equal(forecast.Aliases, []string{"weather"}) {
+		t.Errorf("unexpected command: %+v", forecast)
+	}
+	if subs := commands[1].Subcommands; len(subs) != 1 || subs[0].Name != "알림" {
+		t.Errorf("unexpected subcommands: %+v", subs)
+	}
+}
+
+func TestRouterDuplicatedCommand(t *testing.T) {
+	nop := func(context.Context, *bot.CommandRequest) {}
+	testCases := []struct {
+		desc     string
+		register func(r *bot.Router)
+	}{
+		{desc: "same name", register: func(r *bot.Router) { r.Command("날씨", nop); r.Command("날씨", nop) }},
+		{desc: "alias of other command", register: func(r *bot.Router) { r.Command("날씨", nop).Alias("weather"); r.Command("Weather", nop) }},
+		{desc: "name with space", register: func(r *bot.Router) { r.Command("날씨 예보", nop) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			tc.register(bot.NewRouter())
+		})
+	}
+}
+
+func TestRouterSuggest(t *testing.T) {
+	nop := func(context.Context, *bot.CommandRequest) {}
+	router := bot.NewRouter()
+	router.Command("기능", nop).Alias("help")
+	router.Command("공휴일", nop).Alias("holiday")
+	router.Command("날씨", nop).Alias("weather")
+
+	testCases := []struct {
+		desc  string
+		word  string
+		want  string
+		found bool
+	}{
+		{desc: "consonant typo", word: "날시", want: "날씨", found: true},
+		{desc: "missing final consonant", word: "공후일", want: "공휴일", found: true},
+		{desc: "missing syllable", word: "공휴", want: "공휴일", found: true},
+		{desc: "english alias", word: "Wether", want: "날씨", found: true},
+		{desc: "unrelated word", word: "점심"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, found := router.Suggest(tc.word)
+			if got != tc.want || found != tc.found {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tc.want, tc.found, got, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// 핸들러에서 패닉이 발생했을 때 사용자에게 보여줄 메시지.
+const panicMessage = "요청을 처리하는 중에 문제가 발생했습니다. 잠시 후 다시 시도해 주세요."
+
+// 모든 워커가 바빠서 요청을 처리하지 못할 때 사용자에게 보여줄 메시지.
+const busyMessage = "지금은 요청이 많아 처리할 수 없습니다. 잠시 후 다시 시도해 주세요."
+
+// 워커에서 실행할 이벤트 처리 작업.
+type job struct {
+	kind EventKind
+	// 패닉이 발생하거나 작업을 버릴 때 사용자에게 알릴 주소. 없으면 비어있다.
+	responseURL string
+	// response_url 이 없는 이벤트에서 작업을 버릴 때 사용자에게 알릴 채널과 스레드.
+	// 알릴 곳이 없으면 비어있다.
+	noticeChannel string
+	noticeThread  string
+	handle        func(ctx context.Context)
+}
+
+// 핸들러를 실행할 워커를 시작한다.
+// 반환된 함수는 더 이상 작업이 추가되지 않을 때 호출하며, 처리중인 작업이 끝날 때까지 drainTimeout 만큼 기다린다.
+func (b *Bot) startWorkers(ctx context.Context) (stop func()) {
+	// 종료 신호를 받아도 처리중인 핸들러는 마무리할 수 있도록 연결과 별개의 컨텍스트를 사용한다.
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	b.handlerCtx = handlerCtx
+
+	jobs := make(chan job, b.queueSize)
+	b.jobsMu.Lock()
+	b.jobs = jobs
+	b.jobsMu.Unlock()
+	var workers sync.WaitGroup
+	for range b.workers {
+		workers.Go(func() {
+			for j := range jobs {
+				b.runJob(handlerCtx, j)
+			}
+		})
+	}
+
+	return func() {
+		// 종료한 뒤에 dispatch 를 호출하는 핸들러가 있더라도 닫힌 채널로 보내지 않도록 비운다.
+		b.jobsMu.Lock()
+		close(b.jobs)
+		b.jobs = nil
+		b.jobsMu.Unlock()
+		b.drain(&workers, cancelHandlers)
+		cancelHandlers()
+	}
+}
+
+// 작업을 워커에 전달한다. 모든 워커가 바쁘고 대기열도 가득 차 있으면 기다리지 않고 작업을 버린다.
+// 소켓 모드에서는 읽기 루프가 dispatch 를 호출하므로, 기다리는 동안 핑에 응답하지 못해 연결이 끊기고
+// 슬랙이 envelope 을 다시 보내 밀린 작업이 더 늘어나기 때문이다.
+// 버린 작업은 기록하고 WithDropObserver 로 알리며, 사용자에게 다시 시도해 달라고 알린다.
+// response_url 이 있으면 response_url 로 응답하고, 멘션이나 DM 처럼 없는 이벤트는 채널에 메시지를 보낸다.
+// 봇이 종료되어 작업 채널이 닫힌 뒤에 전달한 작업도 같은 방법으로 버린다.
+func (b *Bot) dispatch(j job) {
+	if b.enqueue(j) {
+		return
+	}
+
+	slog.Warn("all workers are busy or stopped, dropping job", slog.String("event", string(j.kind)), slog.Int("workers", b.workers))
+	if b.observeDrop != nil {
+		b.observeDrop(j.kind)
+	}
+	// 읽기 루프를 막지 않도록 따로 알린다.
+	switch {
+	case j.responseURL != "":
+		go b.respondError(b.handlerCtx, j.responseURL, busyMessage)
+	case j.noticeChannel != "":
+		go b.postError(b.handlerCtx, j.noticeChannel, j.noticeThread, busyMessage)
+	}
+}
+
+// 작업 채널에 자리가 있으면 작업을 넣는다. 작업 채널이 가득 찼거나 닫혔으면 false 를 반환한다.
+func (b *Bot) enqueue(j job) bool {
+	b.jobsMu.RLock()
+	defer b.jobsMu.RUnlock()
+	if b.jobs == nil {
+		return false
+	}
+	select {
+	case b.jobs <- j:
+		return true
+	default:
+		return false
+	}
+}
+
+// 작업을 실행한다. 패닉이 발생하면 봇이 종료되지 않도록 복구하고 사용자에게 오류를 알린다.
+func (b *Bot) runJob(ctx context.Context, j job) {
+	ctx, cancel := context.WithTimeout(ctx, b.handlerTimeout)
+	defer cancel()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		slog.Error("recovered from handler panic",
+			slog.String("event", string(j.kind)),
+			slog.Any("error", fmt.Errorf("%v", r)),
+			slog.String("stack", string(debug.Stack())),
+		)
+		if j.responseURL != "" {
+			b.respondError(ctx, j.responseURL, panicMessage)
+		}
+	}()
+
+	j.handle(ctx)
+}
+
+// 사용자에게만 보이는 오류 메시지로 응답한다.
+func (b *Bot) respondError(ctx context.Context, responseURL string, text string) {
+	// ctx 는 이미 만료되었을 수 있으므로 오류를 알릴 시간을 따로 준다.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	err := b.client.Respond(ctx, responseURL, &slack.InteractiveResponsePayload{
+		ResponseType: slack.Ephemeral,
+		Text:         text,
+	})
+	if err != nil {
+		slog.Error("failed to respond error message", slog.Any("error", err))
+	}
+}
+
+// 채널이나 스레드에 오류 메시지를 보낸다.
+func (b *Bot) postError(ctx context.Context, channel string, thread string, text string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	_, err := b.client.PostMessage(ctx, &slack.PostMessageRequest{
+		Channel:         channel,
+		Text:            text,
+		ThreadTimestamp: thread,
+	})
+	if err != nil {
+		slog.Error("failed to post error message", slog.Any("error", err))
+	}
+}
+
+// 처리중인 작업이 끝날 때까지 drainTimeout 만큼 기다린다.
+// 시간 안에 끝나지 않으면 핸들러의 ctx 를 취소한다.
+func (b *Bot) drain(workers *sync.WaitGroup, cancel context.CancelFunc) {
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(b.drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		slog.Warn("handlers did not finish before drain timeout", slog.Duration("timeout", b.drainTimeout))
+		cancel()
+	}
+}
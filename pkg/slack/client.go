@@ -4,16 +4,164 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"strconv"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/rest"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
 )
 
 const domain = "https://slack.com/api"
 
+// 슬랙 Web API 를 요청 제한에 맞춰 호출할 때 재시도할 최대 횟수.
+const maxRateLimitRetries = 3
+
 type Client struct {
 	AppToken string
 	BotToken string
+	// 요청 제한을 관리한다. 비어있으면 클라이언트마다 새로 만들어 사용한다.
+	// 같은 토큰을 쓰는 클라이언트끼리는 공유해야 제한을 정확히 지킬 수 있다.
+	RateLimiter *RateLimiter
+
+	options     clientOptions
+	limiterOnce sync.Once
+}
+
+func NewClient(appToken string, botToken string, opts ...Option) *Client {
+	options := clientOptions{
+		baseURL: domain,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Client{
+		AppToken:    appToken,
+		BotToken:    botToken,
+		RateLimiter: options.rateLimiter,
+		options:     options,
+	}
+}
+
+func (c *Client) rateLimiter() *RateLimiter {
+	c.limiterOnce.Do(func() {
+		if c.RateLimiter == nil {
+			c.RateLimiter = NewRateLimiter()
+		}
+	})
+	return c.RateLimiter
+}
+
+// 슬랙 Web API 메서드를 호출한다.
+// 메서드 등급에 따라 요청 시점을 조절하고, 429 응답을 받으면 Retry-After 만큼 기다린 뒤 재시도한다.
+// 응답의 ok 필드가 false 이면 *APIError 를 반환한다.
+func (c *Client) call(ctx context.Context, httpMethod string, path string, opts ...rest.Option) ([]byte, error) {
+	return c.callChannel(ctx, "", httpMethod, path, opts...)
+}
+
+// channel 에 메시지를 쓰는 메서드를 호출한다. 요청 제한으로 다시 요청할 때에도
+// 채널마다 초당 1개의 메시지만 게시할 수 있으므로 매 요청마다 채널의 제한을 기다린다.
+// channel 이 비어있으면 채널의 제한은 기다리지 않는다.
+func (c *Client) callChannel(ctx context.Context, channel string, httpMethod string, path string, opts ...rest.Option) (data []byte, err error) {
+	method, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "?")
+	defer c.observe(method, time.Now(), &err)
+	limiter := c.rateLimiter()
+	opts = append(opts, c.requestOptions()...)
+	for attempt := 0; ; attempt++ {
+		if err := limiter.Wait(ctx, method); err != nil {
+			return nil, err
+		}
+		if channel != "" {
+			if err := limiter.WaitChannel(ctx, channel); err != nil {
+				return nil, err
+			}
+		}
+
+		data, err := rest.NewClient(c.baseURL()).RequestAPI(ctx, httpMethod, path, opts...)
+		var respErr *rest.ResponseError
+		if !errors.As(err, &respErr) {
+			if err != nil {
+				return nil, err
+			}
+			return data, checkResult(method, data)
+		}
+		if respErr.StatusCode != http.StatusTooManyRequests {
+			// 오류 응답의 본문이 슬랙의 응답 형식이면 오류 코드를 그대로 전달한다.
+			result := &apiResult{}
+			if json.Unmarshal(respErr.Body, result) == nil && result.Error != "" {
+				return nil, result.err(method)
+			}
+			return nil, err
+		}
+
+		rateLimitedErr := &RateLimitedError{
+			Method:     method,
+			RetryAfter: parseRetryAfter(respErr.Header),
+		}
+		if attempt >= maxRateLimitRetries {
+			return nil, rateLimitedErr
+		}
+		limiter.Pause(method, rateLimitedErr.RetryAfter)
+	}
+}
+
+// 호출이 끝나면 observer 에 결과를 전달한다. defer 로 호출할 수 있도록 오류는 포인터로 받는다.
+func (c *Client) observe(method string, start time.Time, err *error) {
+	if c.options.observe == nil {
+		return
+	}
+	c.options.observe(method, callStatus(*err), time.Since(start))
+}
+
+// 지표의 레이블로 사용할 수 있도록 호출 결과를 짧은 문자열로 나타낸다.
+func callStatus(err error) string {
+	var (
+		apiErr         *APIError
+		rateLimitedErr *RateLimitedError
+	)
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.As(err, &rateLimitedErr):
+		return ErrRateLimited.Code
+	case errors.As(err, &apiErr):
+		return apiErr.Code
+	default:
+		return "error"
+	}
+}
+
+func (c *Client) baseURL() string {
+	if c.options.baseURL == "" {
+		return domain
+	}
+	return c.options.baseURL
+}
+
+func (c *Client) requestOptions() []rest.Option {
+	opts := make([]rest.Option, 0, len(c.options.hooks)+2)
+	if c.options.httpClient != nil {
+		opts = append(opts, rest.WithHTTPClient(*c.options.httpClient))
+	}
+	if c.options.userAgent != "" {
+		userAgent := c.options.userAgent
+		opts = append(opts, rest.WithRequestHook(func(req *http.Request) {
+			req.Header.Set("User-Agent", userAgent)
+		}))
+	}
+	for _, hook := range c.options.hooks {
+		opts = append(opts, rest.WithRequestHook(hook))
+	}
+	return opts
+}
+
+func checkResult(method string, data []byte) error {
+	result := &apiResult{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return err
+	}
+	return result.err(method)
 }
 
 func (c *Client) GetWebSocketURL(ctx context.Context) (*GetWebSocketURLResponse, error) {
@@ -23,7 +171,7 @@ func (c *Client) GetWebSocketURL(ctx context.Context) (*GetWebSocketURLResponse,
 		"Authorization": "Bearer " + c.AppToken,
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
+	data, err := c.call(
 		ctx, "POST", path,
 		rest.WithHeaders(header),
 	)
@@ -36,26 +184,23 @@ func (c *Client) GetWebSocketURL(ctx context.Context) (*GetWebSocketURLResponse,
 		return nil, err
 	}
 
-	if !resp.OK {
-		return nil, errors.New(resp.Error)
-	}
-
 	return resp, nil
 }
 
 func (c *Client) ListChannels(ctx context.Context, req *ListChannelsRequest) (*ListChannelsResponse, error) {
 	path := "/conversations.list"
-	if req != nil {
-		path = path + req.URLParams()
+	if req == nil {
+		req = &ListChannelsRequest{}
 	}
 	header := map[string]string{
 		"Content-Type":  "application/json",
 		"Authorization": "Bearer " + c.BotToken,
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
+	data, err := c.call(
 		ctx, "GET", path,
 		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
 	)
 	if err != nil {
 		return nil, err
@@ -75,13 +220,18 @@ func (c *Client) PostMessage(ctx context.Context, req *PostMessageRequest) (*Pos
 		"Content-Type":  "application/json",
 		"Authorization": "Bearer " + c.BotToken,
 	}
-	body, err := json.Marshal(*req)
+	message := *req
+	if message.Text == "" {
+		// 블록만 있는 메시지는 알림과 스크린 리더에 표시할 텍스트를 블록에서 만든다.
+		message.Text = blockkit.RenderText(message.Blocks)
+	}
+	body, err := json.Marshal(message)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
-		ctx, "POST", path,
+	data, err := c.callChannel(
+		ctx, req.Channel, "POST", path,
 		rest.WithHeaders(header),
 		rest.WithBody(body),
 	)
@@ -97,8 +247,42 @@ func (c *Client) PostMessage(ctx context.Context, req *PostMessageRequest) (*Pos
 	return resp, nil
 }
 
-func (c *Client) ListMessages(ctx context.Context, req *ListMessagesRequest) (*ListMessagesResponse, error) {
-	path := "/conversations.history"
+// 봇이 게시한 메시지를 수정한다.
+func (c *Client) UpdateMessage(ctx context.Context, req *UpdateMessageRequest) (*UpdateMessageResponse, error) {
+	path := "/chat.update"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	message := *req
+	if message.Text == "" {
+		message.Text = blockkit.RenderText(message.Blocks)
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.callChannel(
+		ctx, req.Channel, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &UpdateMessageResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 봇이 게시한 메시지를 삭제한다.
+func (c *Client) DeleteMessage(ctx context.Context, req *DeleteMessageRequest) (*DeleteMessageResponse, error) {
+	path := "/chat.delete"
 	header := map[string]string{
 		"Content-Type":  "application/json",
 		"Authorization": "Bearer " + c.BotToken,
@@ -108,8 +292,8 @@ func (c *Client) ListMessages(ctx context.Context, req *ListMessagesRequest) (*L
 		return nil, err
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
-		ctx, "POST", path,
+	data, err := c.callChannel(
+		ctx, req.Channel, "POST", path,
 		rest.WithHeaders(header),
 		rest.WithBody(body),
 	)
@@ -117,7 +301,7 @@ func (c *Client) ListMessages(ctx context.Context, req *ListMessagesRequest) (*L
 		return nil, err
 	}
 
-	resp := &ListMessagesResponse{}
+	resp := &DeleteMessageResponse{}
 	if err := json.Unmarshal(data, resp); err != nil {
 		return nil, err
 	}
@@ -125,18 +309,86 @@ func (c *Client) ListMessages(ctx context.Context, req *ListMessagesRequest) (*L
 	return resp, nil
 }
 
-func (c *Client) ListReplies(ctx context.Context, req *ListRepliesRequest) (*ListRepliesResponse, error) {
-	path := "/conversations.replies"
+// 채널의 한 사용자에게만 보이는 메시지를 게시한다.
+// 임시 메시지는 전달이 보장되지 않으며, 나중에 수정하거나 삭제할 수 없다.
+func (c *Client) PostEphemeral(ctx context.Context, req *PostEphemeralRequest) (*PostEphemeralResponse, error) {
+	path := "/chat.postEphemeral"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	message := *req
+	if message.Text == "" {
+		message.Text = blockkit.RenderText(message.Blocks)
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.callChannel(
+		ctx, req.Channel, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PostEphemeralResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 지정한 시각에 게시되도록 메시지를 예약한다. 최대 120일 이후까지 예약할 수 있다.
+func (c *Client) ScheduleMessage(ctx context.Context, req *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	path := "/chat.scheduleMessage"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	message := *req
+	if message.Text == "" {
+		message.Text = blockkit.RenderText(message.Blocks)
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.callChannel(
+		ctx, req.Channel, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ScheduleMessageResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 메시지의 고유 링크를 가져온다.
+func (c *Client) GetPermalink(ctx context.Context, req *GetPermalinkRequest) (*GetPermalinkResponse, error) {
+	path := "/chat.getPermalink"
 	param := map[string]string{
-		"channel": req.Channel,
-		"ts":      strconv.FormatFloat(req.Timestamp, 'f', -1, 64),
+		"channel":    req.Channel,
+		"message_ts": req.MessageTimestamp,
 	}
 	header := map[string]string{
 		"Content-Type":  "application/json",
 		"Authorization": "Bearer " + c.BotToken,
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
+	data, err := c.call(
 		ctx, "GET", path,
 		rest.WithHeaders(header),
 		rest.WithParams(param),
@@ -145,6 +397,54 @@ func (c *Client) ListReplies(ctx context.Context, req *ListRepliesRequest) (*Lis
 		return nil, err
 	}
 
+	resp := &GetPermalinkResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) ListMessages(ctx context.Context, req *ListMessagesRequest) (*ListMessagesResponse, error) {
+	path := "/conversations.history"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListMessagesResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) ListReplies(ctx context.Context, req *ListRepliesRequest) (*ListRepliesResponse, error) {
+	path := "/conversations.replies"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &ListRepliesResponse{}
 	if err := json.Unmarshal(data, resp); err != nil {
 		return nil, err
@@ -164,7 +464,7 @@ func (c *Client) GetUserProfile(ctx context.Context, userID string) (*UserProfil
 		"Authorization": "Bearer " + c.BotToken,
 	}
 
-	data, err := rest.NewClient(domain).RequestAPI(
+	data, err := c.call(
 		ctx, "GET", path,
 		rest.WithHeaders(header),
 		rest.WithParams(param),
@@ -172,10 +472,271 @@ func (c *Client) GetUserProfile(ctx context.Context, userID string) (*UserProfil
 	if err != nil {
 		return nil, err
 	}
-	profile := &UserProfile{}
-	if err := json.Unmarshal(data, profile); err != nil {
+	resp := &GetUserProfileResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Profile, nil
+}
+
+// 워크스페이스의 사용자 목록을 가져온다. 비활성화된 사용자와 봇도 포함된다.
+func (c *Client) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	path := "/users.list"
+	if req == nil {
+		req = &ListUsersRequest{}
+	}
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	return profile, nil
+	resp := &ListUsersResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 사용자 정보를 가져온다. 사용자가 없으면 ErrUserNotFound 를 반환한다.
+func (c *Client) GetUserInfo(ctx context.Context, userID string) (*User, error) {
+	path := "/users.info"
+
+	param := map[string]string{
+		"user": userID,
+	}
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(param),
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &UserResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.User, nil
+}
+
+// 이메일로 사용자를 찾는다. 사용자가 없으면 ErrUsersNotFound 를 반환한다.
+// 봇 토큰에 users:read.email 권한이 있어야 한다.
+func (c *Client) LookupUserByEmail(ctx context.Context, email string) (*User, error) {
+	path := "/users.lookupByEmail"
+
+	param := map[string]string{
+		"email": email,
+	}
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(param),
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &UserResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.User, nil
+}
+
+// 워크스페이스의 사용자 그룹 목록을 가져온다.
+func (c *Client) ListUsergroups(ctx context.Context, req *ListUsergroupsRequest) (*ListUsergroupsResponse, error) {
+	path := "/usergroups.list"
+	if req == nil {
+		req = &ListUsergroupsRequest{}
+	}
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListUsergroupsResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 사용자 그룹에 속한 사용자의 ID 목록을 가져온다.
+func (c *Client) ListUsergroupUsers(ctx context.Context, req *ListUsergroupUsersRequest) (*ListUsergroupUsersResponse, error) {
+	path := "/usergroups.users.list"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+
+	data, err := c.call(
+		ctx, "GET", path,
+		rest.WithHeaders(header),
+		rest.WithParams(req.params()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListUsergroupUsersResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 모달을 연다. trigger_id 는 발급 후 3초 안에 사용해야 한다.
+func (c *Client) OpenView(ctx context.Context, req *OpenViewRequest) (*ViewResponse, error) {
+	path := "/views.open"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	body, err := json.Marshal(*req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.call(
+		ctx, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ViewResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 열려있는 모달 위에 새로운 뷰를 쌓는다. 최대 3개까지 쌓을 수 있다.
+func (c *Client) PushView(ctx context.Context, req *PushViewRequest) (*ViewResponse, error) {
+	path := "/views.push"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	body, err := json.Marshal(*req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.call(
+		ctx, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ViewResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 열려있는 모달의 뷰를 갱신한다.
+func (c *Client) UpdateView(ctx context.Context, req *UpdateViewRequest) (*ViewResponse, error) {
+	path := "/views.update"
+	header := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer " + c.BotToken,
+	}
+	body, err := json.Marshal(*req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.call(
+		ctx, "POST", path,
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ViewResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 슬래시 커맨드나 상호작용 이벤트의 response_url 로 응답을 보낸다.
+// response_url 은 Web API 가 아니므로 요청 제한을 적용하지 않는다.
+func (c *Client) Respond(ctx context.Context, responseURL string, payload *InteractiveResponsePayload) (err error) {
+	// 슬랙에서 invalid_blocks 로 거절되기 전에 블록의 제약 조건을 먼저 검사한다.
+	if err := payload.Blocks.Validate(); err != nil {
+		return err
+	}
+
+	response := *payload
+	if response.Text == "" {
+		response.Text = blockkit.RenderText(response.Blocks)
+	}
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	header := map[string]string{
+		"Content-Type": "application/json",
+	}
+	opts := append([]rest.Option{
+		rest.WithHeaders(header),
+		rest.WithBody(body),
+	}, c.requestOptions()...)
+	defer c.observe("response_url", time.Now(), &err)
+	_, err = rest.NewClient(responseURL).RequestAPI(ctx, "POST", "", opts...)
+	var respErr *rest.ResponseError
+	if errors.As(err, &respErr) {
+		result := &apiResult{}
+		if json.Unmarshal(respErr.Body, result) == nil && result.Error != "" {
+			return result.err("response_url")
+		}
+	}
+	return err
 }
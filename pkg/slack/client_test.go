@@ -0,0 +1,307 @@
+package slack_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+func TestClientOptions(t *testing.T) {
+	var hooked atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/chat.postMessage" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("User-Agent"); got != "jarvis-test" {
+			t.Errorf("unexpected user agent: %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer xoxb-test" {
+			t.Errorf("unexpected authorization: %q", got)
+		}
+
+		req := &slack.PostMessageRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// 텍스트가 비어있으면 블록에서 만든 텍스트를 함께 보낸다.
+		if req.Text != "*공지*" {
+			t.Errorf("unexpected text: %q", req.Text)
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "channel": "C1", "ts": "1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	client := slack.NewClient(
+		"xapp-test", "xoxb-test",
+		slack.WithBaseURL(server.URL+"/api"),
+		slack.WithHTTPClient(*server.Client()),
+		slack.WithUserAgent("jarvis-test"),
+		slack.WithRequestHook(func(req *http.Request) { hooked.Store(true) }),
+	)
+	resp, err := client.PostMessage(context.Background(), &slack.PostMessageRequest{
+		Channel: "C1",
+		Blocks:  blockkit.Blocks{blockkit.NewHeaderBlock("공지")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Timestamp != "1700000000.000100" {
+		t.Errorf("unexpected timestamp: %v", resp.Timestamp)
+	}
+	if !hooked.Load() {
+		t.Errorf("expected request hook to be called")
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"ok": false,
+			"error": "invalid_blocks",
+			"response_metadata": {"messages": ["[ERROR] missing required field: text"]}
+		}`))
+	}))
+	defer server.Close()
+
+	client := slack.NewClient("", "", slack.WithBaseURL(server.URL))
+	_, err := client.PostMessage(context.Background(), &slack.PostMessageRequest{Channel: "C1", Text: "a"})
+	if !errors.Is(err, slack.ErrInvalidBlocks) {
+		t.Fatalf("expected invalid_blocks, got %v", err)
+	}
+
+	var apiErr *slack.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *slack.APIError, got %T", err)
+	}
+	if apiErr.Method != "chat.postMessage" {
+		t.Errorf("unexpected method: %s", apiErr.Method)
+	}
+	if len(apiErr.Messages) != 1 {
+		t.Errorf("unexpected messages: %v", apiErr.Messages)
+	}
+}
+
+// ts 는 숫자로 변환하면 끝자리의 0 이 사라지므로 받은 문자열 그대로 전달해야 한다.
+func TestClientTimestampPassthrough(t *testing.T) {
+	const ts = "1503435956.000240"
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.URL.Query().Get("message_ts"); v != "" {
+			got = append(got, v)
+		}
+		if r.Method == http.MethodPost {
+			var body struct {
+				Timestamp string `json:"ts"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			got = append(got, body.Timestamp)
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "channel": "C1", "ts": "` + ts + `"}`))
+	}))
+	defer server.Close()
+
+	client := slack.NewClient("", "", slack.WithBaseURL(server.URL))
+	ctx := context.Background()
+	updated, err := client.UpdateMessage(ctx, &slack.UpdateMessageRequest{Channel: "C1", Timestamp: ts, Text: "a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.DeleteMessage(ctx, &slack.DeleteMessageRequest{Channel: "C1", Timestamp: ts}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetPermalink(ctx, &slack.GetPermalinkRequest{Channel: "C1", MessageTimestamp: ts}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updated.Timestamp != ts {
+		t.Errorf("expected %s, got %s", ts, updated.Timestamp)
+	}
+	if want := []string{ts, ts, ts}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestClientRetryAfter(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		failures  int32
+		wantCalls int32
+		wantErr   error
+	}{
+		{
+			desc:      "retry once",
+			failures:  1,
+			wantCalls: 2,
+		},
+		{
+			desc:      "give up after retries",
+			failures:  100,
+			wantCalls: 4,
+			wantErr:   slack.ErrRateLimited,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) <= tc.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"ok": false, "error": "ratelimited"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"ok": true, "profile": {"real_name": "Amy"}}`))
+			}))
+			defer server.Close()
+
+			client := slack.NewClient("", "", slack.WithBaseURL(server.URL))
+			profile, err := client.GetUserProfile(context.Background(), "U1")
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if err == nil && profile.RealName != "Amy" {
+				t.Errorf("unexpected profile: %+v", profile)
+			}
+			if got := calls.Load(); got != tc.wantCalls {
+				t.Errorf("expected %d calls, got %d", tc.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestClientChannelRateLimit(t *testing.T) {
+	testCases := []struct {
+		desc string
+		// 첫 번째 요청이 429 응답을 받는지 여부.
+		rateLimited bool
+		post        func(ctx context.Context, client *slack.Client) error
+	}{
+		{
+			desc: "chat.postMessage",
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "안녕"})
+				return err
+			},
+		},
+		{
+			desc: "chat.postEphemeral",
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.PostEphemeral(ctx, &slack.PostEphemeralRequest{Channel: "C1", User: "U1", Text: "안녕"})
+				return err
+			},
+		},
+		{
+			desc: "chat.scheduleMessage",
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.ScheduleMessage(ctx, &slack.ScheduleMessageRequest{Channel: "C1", PostAt: time.Now().Add(time.Hour).Unix(), Text: "안녕"})
+				return err
+			},
+		},
+		{
+			desc: "chat.update",
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.UpdateMessage(ctx, &slack.UpdateMessageRequest{Channel: "C1", Timestamp: "1700000000.000100", Text: "안녕"})
+				return err
+			},
+		},
+		{
+			desc: "chat.delete",
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.DeleteMessage(ctx, &slack.DeleteMessageRequest{Channel: "C1", Timestamp: "1700000000.000100"})
+				return err
+			},
+		},
+		{
+			desc:        "retry after 429",
+			rateLimited: true,
+			post: func(ctx context.Context, client *slack.Client) error {
+				_, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "안녕"})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 && tc.rateLimited {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"ok": false, "error": "ratelimited"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"ok": true}`))
+			}))
+			defer server.Close()
+			client := slack.NewClient("", "", slack.WithBaseURL(server.URL))
+
+			// 다시 요청하거나 이어서 요청해도 같은 채널에는 초당 1개의 메시지만 보낸다.
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			err := tc.post(ctx, client)
+			if !tc.rateLimited {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				err = tc.post(ctx, client)
+			}
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("expected deadline exceeded, got %v", err)
+			}
+			if got := calls.Load(); got != 1 {
+				t.Errorf("expected 1 call, got %d", got)
+			}
+		})
+	}
+}
+
+func TestClientCallObserver(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		status     int
+		body       string
+		wantStatus string
+	}{
+		{desc: "ok", status: http.StatusOK, body: `{"ok": true}`, wantStatus: "ok"},
+		{desc: "api error", status: http.StatusNotFound, body: `{"ok": false, "error": "channel_not_found"}`, wantStatus: "channel_not_found"},
+		{desc: "rate limited", status: http.StatusTooManyRequests, body: `{"ok": false, "error": "ratelimited"}`, wantStatus: "ratelimited"},
+		{desc: "server error", status: http.StatusInternalServerError, body: "", wantStatus: "error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			var observed []string
+			client := slack.NewClient("", "", slack.WithBaseURL(server.URL),
+				slack.WithCallObserver(func(method string, status string, _ time.Duration) {
+					observed = append(observed, method+":"+status)
+				}),
+			)
+			_, _ = client.PostMessage(context.Background(), &slack.PostMessageRequest{Channel: "C1", Text: "a"})
+			_ = client.Respond(context.Background(), server.URL, &slack.InteractiveResponsePayload{Text: "a"})
+
+			// 재시도하더라도 호출 한 번으로 기록한다.
+			want := []string{"chat.postMessage:" + tc.wantStatus, "response_url:" + tc.wantStatus}
+			if !slices.Equal(observed, want) {
+				t.Errorf("expected %q, got %q", want, observed)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// users.list 를 한 번에 요청할 사용자 수.
+const directoryPageSize = 200
+
+// 워크스페이스 사용자 목록을 메모리에 들고 ID, 이메일, 이름으로 찾는다.
+// 캐시에 없는 ID 와 이메일은 users.info, users.lookupByEmail 로 조회해서 캐시에 추가한다.
+type Directory struct {
+	client *Client
+
+	mu          sync.RWMutex
+	users       []User
+	byID        map[string]int
+	byEmail     map[string]int
+	byName      map[string][]int
+	refreshedAt time.Time
+	// 개별 조회한 사용자를 캐시에 넣은 시각. 갱신하는 동안 넣은 사용자를 유지할 때 사용한다.
+	addedAt map[string]time.Time
+}
+
+func NewDirectory(client *Client) *Directory {
+	return &Directory{
+		client:  client,
+		byID:    make(map[string]int),
+		byEmail: make(map[string]int),
+		byName:  make(map[string][]int),
+		addedAt: make(map[string]time.Time),
+	}
+}
+
+// 모든 사용자를 다시 조회해서 캐시를 바꾼다. 조회에 실패하면 기존 캐시를 그대로 둔다.
+// 조회하는 동안 개별 조회로 캐시에 넣은 사용자는 목록보다 최신이므로 그대로 유지한다.
+func (d *Directory) Refresh(ctx context.Context) error {
+	started := time.Now()
+	users, err := d.client.ListAllUsers(ctx, &ListUsersRequest{Limit: directoryPageSize}, 0)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var added []User
+	addedAt := make(map[string]time.Time)
+	for id, at := range d.addedAt {
+		if !at.Before(started) {
+			added = append(added, d.users[d.byID[id]])
+			addedAt[id] = at
+		}
+	}
+
+	d.users = make([]User, 0, len(users)+len(added))
+	d.byID = make(map[string]int, len(users))
+	d.byEmail = make(map[string]int, len(users))
+	d.byName = make(map[string][]int, len(users))
+	d.addedAt = addedAt
+	for _, user := range users {
+		d.put(user)
+	}
+	for _, user := range added {
+		d.put(user)
+	}
+	d.refreshedAt = time.Now()
+	return nil
+}
+
+// interval 마다 캐시를 갱신한다. 시작할 때 한 번 갱신하며, ctx 가 끝나면 멈춘다.
+// 갱신에 실패하면 기록만 하고 다음 주기에 다시 시도한다.
+func (d *Directory) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := d.Refresh(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("failed to refresh directory", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 마지막으로 캐시를 갱신한 시각. 한 번도 갱신하지 않았으면 zero 값이다.
+func (d *Directory) RefreshedAt() time.Time {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.refreshedAt
+}
+
+// 한 번도 갱신하지 않았으면 먼저 캐시를 채운다.
+func (d *Directory) load(ctx context.Context) error {
+	if !d.RefreshedAt().IsZero() {
+		return nil
+	}
+	return d.Refresh(ctx)
+}
+
+// 캐시된 모든 사용자. 비활성화된 사용자와 봇도 포함된다.
+func (d *Directory) Users(ctx context.Context) ([]User, error) {
+	if err := d.load(ctx); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return slices.Clone(d.users), nil
+}
+
+// ID 로 사용자를 찾는다. 캐시에 없으면 users.info 로 조회한다.
+func (d *Directory) User(ctx context.Context, userID string) (*User, error) {
+	d.mu.RLock()
+	i, ok := d.byID[userID]
+	if ok {
+		user := d.users[i]
+		d.mu.RUnlock()
+		return &user, nil
+	}
+	d.mu.RUnlock()
+
+	user, err := d.client.GetUserInfo(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	d.add(*user)
+	return user, nil
+}
+
+// 이메일로 사용자를 찾는다. 대소문자는 구분하지 않는다.
+// 캐시에 없으면 users.lookupByEmail 로 조회한다.
+func (d *Directory) UserByEmail(ctx context.Context, email string) (*User, error) {
+	key := normalizeEmail(email)
+	if key == "" {
+		return nil, ErrUsersNotFound
+	}
+	d.mu.RLock()
+	i, ok := d.byEmail[key]
+	if ok {
+		user := d.users[i]
+		d.mu.RUnlock()
+		return &user, nil
+	}
+	d.mu.RUnlock()
+
+	user, err := d.client.LookupUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	d.add(*user)
+	return user, nil
+}
+
+// 이름으로 활성 사용자를 찾는다. 동명이인이 있을 수 있으므로 일치하는 사용자를 모두 반환한다.
+// 실명, 표시 이름, 성과 이름을 이어붙인 이름(e.g. "홍" + "길동") 중 하나와 같으면 일치하는 것으로 보며,
+// 공백과 "홍길동(개발팀)" 처럼 괄호로 덧붙인 부분, "님" 과 같은 호칭은 무시한다.
+func (d *Directory) UsersByName(ctx context.Context, name string) ([]User, error) {
+	if err := d.load(ctx); err != nil {
+		return nil, err
+	}
+	key := normalizeName(strings.TrimSuffix(strings.TrimSpace(name), "님"))
+	if key == "" {
+		return nil, nil
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	indexes := d.byName[key]
+	users := make([]User, 0, len(indexes))
+	for _, i := range indexes {
+		users = append(users, d.users[i])
+	}
+	return users, nil
+}
+
+// 개별 조회한 사용자를 캐시에 추가한다. 다음 갱신 때 목록 전체가 바뀐다.
+func (d *Directory) add(user User) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.put(user)
+	d.addedAt[user.ID] = time.Now()
+}
+
+// 사용자를 캐시에 넣는다. 이미 있는 사용자는 이메일과 이름이 바뀌었을 수 있으므로
+// 기존 색인을 지우고 다시 색인한다. d.mu 를 잠근 상태에서 호출해야 한다.
+func (d *Directory) put(user User) {
+	i, ok := d.byID[user.ID]
+	if ok {
+		d.unindex(i)
+		d.users[i] = user
+	} else {
+		i = len(d.users)
+		d.users = append(d.users, user)
+		d.byID[user.ID] = i
+	}
+	// 비활성화된 사용자는 ID 로만 찾을 수 있다.
+	if user.Deleted {
+		return
+	}
+	if email := normalizeEmail(user.Profile.Email); email != "" {
+		d.byEmail[email] = i
+	}
+	for _, key := range nameKeys(&user) {
+		if !slices.Contains(d.byName[key], i) {
+			d.byName[key] = append(d.byName[key], i)
+		}
+	}
+}
+
+// i 번째 사용자의 이메일과 이름을 색인에서 지운다.
+func (d *Directory) unindex(i int) {
+	user := &d.users[i]
+	if email := normalizeEmail(user.Profile.Email); email != "" {
+		if j, ok := d.byEmail[email]; ok && j == i {
+			delete(d.byEmail, email)
+		}
+	}
+	for _, key := range nameKeys(user) {
+		indexes := slices.DeleteFunc(d.byName[key], func(j int) bool { return j == i })
+		if len(indexes) == 0 {
+			delete(d.byName, key)
+		} else {
+			d.byName[key] = indexes
+		}
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// 사용자를 찾을 수 있는 이름의 목록.
+func nameKeys(user *User) []string {
+	profile := &user.Profile
+	candidates := []string{
+		user.RealName,
+		profile.RealName,
+		profile.DisplayName,
+		// 한국어 이름은 성을 먼저 쓴다.
+		profile.LastName + profile.FirstName,
+		profile.FirstName + " " + profile.LastName,
+	}
+	var keys []string
+	for _, candidate := range candidates {
+		if key := normalizeName(candidate); key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// 괄호로 덧붙인 부분과 공백을 제거하고 소문자로 바꾼다. e.g. "홍길동 (개발팀)" -> "홍길동"
+func normalizeName(name string) string {
+	if i := strings.IndexAny(name, "(（["); i > 0 {
+		name = name[:i]
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
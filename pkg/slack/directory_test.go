@@ -0,0 +1,274 @@
+package slack_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func countRequests(server *slacktest.Server, method string) int {
+	n := 0
+	for _, req := range server.Requests() {
+		if req.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDirectory(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUser(slack.User{
+		ID:       "U1",
+		RealName: "홍길동",
+		TZ:       "Asia/Seoul",
+		Profile: slack.UserProfile{
+			RealName:    "홍길동",
+			DisplayName: "홍길동 (개발팀)",
+			FirstName:   "길동",
+			LastName:    "홍",
+			Email:       "gildong@example.com",
+		},
+	})
+	server.AddUser(slack.User{
+		ID:      "U2",
+		Profile: slack.UserProfile{RealName: "Gildong Hong", FirstName: "길동", LastName: "홍", Email: "hong@example.com"},
+	})
+	server.AddUser(slack.User{
+		ID:      "U3",
+		Deleted: true,
+		Profile: slack.UserProfile{RealName: "홍길동", Email: "old@example.com"},
+	})
+	// 한 페이지로 조회할 수 없을 만큼 사용자를 추가한다.
+	for i := range 250 {
+		server.AddUser(slack.User{ID: fmt.Sprintf("U%03d", i+100), Profile: slack.UserProfile{RealName: "김철수"}})
+	}
+
+	ctx := context.Background()
+	directory := slack.NewDirectory(slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...))
+	if err := directory.Refresh(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if directory.RefreshedAt().IsZero() {
+		t.Error("expected refreshed time")
+	}
+	users, err := directory.Users(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 253 {
+		t.Errorf("expected 253 users, got %d", len(users))
+	}
+
+	testCases := []struct {
+		desc string
+		name string
+		want []string
+	}{
+		{desc: "real name", name: "홍길동", want: []string{"U1", "U2"}},
+		{desc: "honorific and spaces", name: " 홍 길동님", want: []string{"U1", "U2"}},
+		{desc: "display name with team", name: "홍길동(개발팀)", want: []string{"U1", "U2"}},
+		{desc: "english name ignores case", name: "gildong hong", want: []string{"U2"}},
+		{desc: "unknown name", name: "이순신", want: []string{}},
+		{desc: "empty name", name: "", want: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			users, err := directory.UsersByName(ctx, tc.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]string, 0, len(users))
+			for _, user := range users {
+				ids = append(ids, user.ID)
+			}
+			if !slices.Equal(ids, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, ids)
+			}
+		})
+	}
+
+	// 캐시된 사용자는 슬랙을 호출하지 않고 찾는다.
+	user, err := directory.User(ctx, "U1")
+	if err != nil || user.TZ != "Asia/Seoul" {
+		t.Errorf("unexpected user: %+v, %v", user, err)
+	}
+	user, err = directory.UserByEmail(ctx, "GilDong@Example.com")
+	if err != nil || user.ID != "U1" {
+		t.Errorf("unexpected user: %+v, %v", user, err)
+	}
+	if n := countRequests(server, "users.info") + countRequests(server, "users.lookupByEmail"); n != 0 {
+		t.Errorf("expected no lookup requests, got %d", n)
+	}
+
+	// 비활성화된 사용자는 ID 로만 찾을 수 있다.
+	if user, err := directory.User(ctx, "U3"); err != nil || !user.Deleted {
+		t.Errorf("unexpected user: %+v, %v", user, err)
+	}
+
+	// 갱신한 뒤에 추가된 사용자는 슬랙에서 조회해서 캐시에 추가한다.
+	server.AddUser(slack.User{ID: "U4", Profile: slack.UserProfile{RealName: "이순신", Email: "sunsin@example.com"}})
+	if user, err := directory.UserByEmail(ctx, "sunsin@example.com"); err != nil || user.ID != "U4" {
+		t.Errorf("unexpected user: %+v, %v", user, err)
+	}
+	if user, err := directory.User(ctx, "U4"); err != nil || user.ID != "U4" {
+		t.Errorf("unexpected user: %+v, %v", user, err)
+	}
+	if users, _ := directory.UsersByName(ctx, "이순신"); len(users) != 1 {
+		t.Errorf("expected 1 user, got %d", len(users))
+	}
+	if n := countRequests(server, "users.lookupByEmail"); n != 1 {
+		t.Errorf("expected 1 lookup request, got %d", n)
+	}
+
+	if _, err := directory.User(ctx, "U404"); !errors.Is(err, slack.ErrUserNotFound) {
+		t.Errorf("expected user_not_found, got %v", err)
+	}
+	if _, err := directory.UserByEmail(ctx, "nobody@example.com"); !errors.Is(err, slack.ErrUsersNotFound) {
+		t.Errorf("expected users_not_found, got %v", err)
+	}
+}
+
+func TestDirectoryLoadsLazily(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUser(slack.User{ID: "U1", Profile: slack.UserProfile{RealName: "홍길동"}})
+
+	ctx := context.Background()
+	directory := slack.NewDirectory(slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...))
+	users, err := directory.UsersByName(ctx, "홍길동")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("expected 1 user, got %d", len(users))
+	}
+	if _, err := directory.Users(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := countRequests(server, "users.list"); n != 1 {
+		t.Errorf("expected 1 users.list request, got %d", n)
+	}
+
+	// 갱신에 실패하면 기존 캐시를 그대로 둔다.
+	server.SetError("users.list", "internal_error")
+	if err := directory.Refresh(ctx); err == nil {
+		t.Error("expected error")
+	}
+	if users, _ := directory.UsersByName(ctx, "홍길동"); len(users) != 1 {
+		t.Errorf("expected 1 user, got %d", len(users))
+	}
+}
+
+func TestDirectoryReindexesChangedUsers(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUser(slack.User{ID: "U1", Profile: slack.UserProfile{RealName: "홍길동", Email: "gildong@example.com"}})
+	server.AddUser(slack.User{ID: "U2", Profile: slack.UserProfile{RealName: "이순신", Email: "sunsin@example.com"}})
+
+	ctx := context.Background()
+	directory := slack.NewDirectory(slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...))
+	if err := directory.Refresh(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 이메일과 이름이 바뀐 사용자와 비활성화된 사용자를 다시 조회한다.
+	server.AddUser(slack.User{ID: "U1", Profile: slack.UserProfile{RealName: "홍길순", Email: "gilsun@example.com"}})
+	server.AddUser(slack.User{ID: "U2", Deleted: true, Profile: slack.UserProfile{RealName: "이순신", Email: "admiral@example.com"}})
+	for _, email := range []string{"gilsun@example.com", "admiral@example.com"} {
+		if _, err := directory.UserByEmail(ctx, email); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		desc string
+		name string
+		want int
+	}{
+		{desc: "old name", name: "홍길동", want: 0},
+		{desc: "new name", name: "홍길순", want: 1},
+		{desc: "deleted user", name: "이순신", want: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			users, err := directory.UsersByName(ctx, tc.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(users) != tc.want {
+				t.Errorf("expected %d users, got %d", tc.want, len(users))
+			}
+		})
+	}
+
+	// 이전 이메일은 캐시에서 찾지 않고 슬랙에 다시 조회한다.
+	for _, email := range []string{"gildong@example.com", "sunsin@example.com"} {
+		if _, err := directory.UserByEmail(ctx, email); !errors.Is(err, slack.ErrUsersNotFound) {
+			t.Errorf("expected users_not_found for %s, got %v", email, err)
+		}
+	}
+}
+
+func TestDirectoryKeepsUsersAddedDuringRefresh(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUser(slack.User{ID: "U1", Profile: slack.UserProfile{RealName: "홍길동", Email: "old@example.com"}})
+	// 두 페이지로 나누어 조회할 만큼 사용자를 추가한다.
+	for i := range 250 {
+		server.AddUser(slack.User{ID: fmt.Sprintf("U%03d", i+100), Profile: slack.UserProfile{RealName: "김철수"}})
+	}
+
+	// 두 번째 페이지를 요청하기 전에 멈춘다.
+	var lists atomic.Int32
+	paused := make(chan struct{})
+	resume := make(chan struct{})
+	hook := slack.WithRequestHook(func(req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, "users.list") && lists.Add(1) == 2 {
+			close(paused)
+			<-resume
+		}
+	})
+
+	ctx := context.Background()
+	directory := slack.NewDirectory(slack.NewClient("xapp-test", "xoxb-test", append(server.ClientOptions(), hook)...))
+	done := make(chan error, 1)
+	go func() { done <- directory.Refresh(ctx) }()
+	<-paused
+
+	// 첫 페이지를 받은 뒤에 바뀐 사용자를 개별 조회한다.
+	server.AddUser(slack.User{ID: "U1", Profile: slack.UserProfile{RealName: "홍길동", Email: "new@example.com"}})
+	if _, err := directory.UserByEmail(ctx, "new@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(resume)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	user, err := directory.User(ctx, "U1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Profile.Email != "new@example.com" {
+		t.Errorf("expected updated email, got %q", user.Profile.Email)
+	}
+	if n := countRequests(server, "users.lookupByEmail"); n != 1 {
+		t.Errorf("expected 1 lookup request, got %d", n)
+	}
+	if _, err := directory.UserByEmail(ctx, "new@example.com"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if n := countRequests(server, "users.lookupByEmail"); n != 1 {
+		t.Errorf("expected cached user, got %d lookup requests", n)
+	}
+}
@@ -0,0 +1,81 @@
+package slack
+
+import (
+	"strings"
+)
+
+// 슬랙 Web API 가 ok 가 아닌 응답을 반환한 경우의 오류.
+// errors.Is 로 ErrChannelNotFound 와 같은 오류 코드별 값과 비교할 수 있다.
+type APIError struct {
+	// 호출한 API 메서드. e.g. "chat.postMessage"
+	Method string
+	// 슬랙이 반환한 오류 코드. e.g. "channel_not_found"
+	Code string
+	// 요청은 처리되었지만 슬랙이 함께 알려준 경고.
+	Warnings []string
+	// 오류에 대한 자세한 설명. invalid_blocks 와 같은 오류에서 어느 블록이 잘못되었는지 알려준다.
+	Messages []string
+}
+
+func (e *APIError) Error() string {
+	var builder strings.Builder
+	builder.WriteString("slack: ")
+	if e.Method != "" {
+		builder.WriteString(e.Method)
+		builder.WriteString(": ")
+	}
+	builder.WriteString(e.Code)
+	if len(e.Messages) > 0 {
+		builder.WriteString(" (")
+		builder.WriteString(strings.Join(e.Messages, ", "))
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
+
+// 오류 코드가 같으면 같은 오류로 본다. target 에 메서드가 지정된 경우 메서드도 같아야 한다.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return t.Code == e.Code && (t.Method == "" || t.Method == e.Method)
+}
+
+var (
+	ErrChannelNotFound = &APIError{Code: "channel_not_found"}
+	ErrNotInChannel    = &APIError{Code: "not_in_channel"}
+	ErrInvalidAuth     = &APIError{Code: "invalid_auth"}
+	ErrRateLimited     = &APIError{Code: "ratelimited"}
+	ErrInvalidBlocks   = &APIError{Code: "invalid_blocks"}
+	ErrUserNotFound    = &APIError{Code: "user_not_found"}
+	// users.lookupByEmail 은 user_not_found 대신 users_not_found 를 반환한다.
+	ErrUsersNotFound = &APIError{Code: "users_not_found"}
+)
+
+// ok 필드와 함께 오류의 자세한 정보를 확인하기 위한 응답.
+type apiResult struct {
+	OK       bool   `json:"ok"`
+	Error    string `json:"error"`
+	Warning  string `json:"warning"`
+	Metadata struct {
+		Messages []string `json:"messages"`
+		Warnings []string `json:"warnings"`
+	} `json:"response_metadata"`
+}
+
+func (r *apiResult) err(method string) error {
+	if r.OK {
+		return nil
+	}
+	warnings := r.Metadata.Warnings
+	if len(warnings) == 0 && r.Warning != "" {
+		warnings = strings.Split(r.Warning, ",")
+	}
+	return &APIError{
+		Method:   method,
+		Code:     r.Error,
+		Warnings: warnings,
+		Messages: r.Metadata.Messages,
+	}
+}
@@ -0,0 +1,76 @@
+package slack_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			desc:   "same code",
+			err:    &slack.APIError{Method: "chat.postMessage", Code: "channel_not_found"},
+			target: slack.ErrChannelNotFound,
+			want:   true,
+		},
+		{
+			desc:   "wrapped",
+			err:    fmt.Errorf("send: %w", &slack.APIError{Method: "chat.postMessage", Code: "not_in_channel"}),
+			target: slack.ErrNotInChannel,
+			want:   true,
+		},
+		{
+			desc:   "different code",
+			err:    &slack.APIError{Method: "chat.postMessage", Code: "invalid_blocks"},
+			target: slack.ErrChannelNotFound,
+			want:   false,
+		},
+		{
+			desc:   "same method",
+			err:    &slack.APIError{Method: "views.open", Code: "invalid_auth"},
+			target: &slack.APIError{Method: "views.open", Code: "invalid_auth"},
+			want:   true,
+		},
+		{
+			desc:   "different method",
+			err:    &slack.APIError{Method: "views.open", Code: "invalid_auth"},
+			target: &slack.APIError{Method: "chat.postMessage", Code: "invalid_auth"},
+			want:   false,
+		},
+		{
+			desc:   "rate limited after retries",
+			err:    &slack.RateLimitedError{Method: "chat.postMessage", RetryAfter: time.Second},
+			target: slack.ErrRateLimited,
+			want:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := errors.Is(tc.err, tc.target); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIErrorError(t *testing.T) {
+	err := &slack.APIError{
+		Method:   "chat.postMessage",
+		Code:     "invalid_blocks",
+		Messages: []string{"[ERROR] must be less than 151 characters [json-pointer:/blocks/0/text]"},
+	}
+	want := "slack: chat.postMessage: invalid_blocks ([ERROR] must be less than 151 characters [json-pointer:/blocks/0/text])"
+	if got := err.Error(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -12,6 +12,7 @@ const (
 	EventTypeDisconnect   EventType = "disconnect"
 	EventTypeSlashCommand EventType = "slash_commands"
 	EventTypeInteractive  EventType = "interactive"
+	EventTypeEventsAPI    EventType = "events_api"
 )
 
 type SlackEvent interface {
@@ -72,6 +73,21 @@ func (i *InteractiveEvent) EventType() EventType {
 	return i.Type
 }
 
+type EventsAPIEvent struct {
+	Type                   EventType        `json:"type"`
+	EnvelopeID             string           `json:"envelope_id"`
+	AcceptsResponsePayload bool             `json:"accepts_response_payload"`
+	Payload                EventsAPIPayload `json:"payload"`
+	// 슬랙이 이벤트를 재전송한 횟수. 처음 전송된 이벤트는 0 이다.
+	RetryAttempt int `json:"retry_attempt"`
+	// 이벤트를 재전송한 이유. (e.g. timeout)
+	RetryReason string `json:"retry_reason"`
+}
+
+func (e *EventsAPIEvent) EventType() EventType {
+	return e.Type
+}
+
 func UnmarshalSlackEvent(data []byte) (SlackEvent, error) {
 	var raw = struct {
 		Type EventType `json:"type"`
@@ -102,6 +118,9 @@ func UnmarshalSlackEvent(data []byte) (SlackEvent, error) {
 	case EventTypeInteractive:
 		e := InteractiveEvent{}
 		return unmarshal(data, &e)
+	case EventTypeEventsAPI:
+		e := EventsAPIEvent{}
+		return unmarshal(data, &e)
 	default:
 		return nil, errors.New("undefined type: " + string(raw.Type))
 	}
@@ -0,0 +1,173 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func TestUnmarshalEventsAPIEvent(t *testing.T) {
+	testCases := []struct {
+		desc string
+		data string
+		want slack.InnerEventType
+	}{
+		{
+			desc: "app_mention",
+			data: `{
+				"envelope_id": "57d6a792-4d35-4d0b-b6aa-3361493e1caf",
+				"type": "events_api",
+				"accepts_response_payload": false,
+				"retry_attempt": 0,
+				"retry_reason": "",
+				"payload": {
+					"team_id": "T012AB3C4",
+					"api_app_id": "A0KRD7HC3",
+					"type": "event_callback",
+					"event_id": "Ev9UQ52YNA",
+					"event_time": 1515449522,
+					"event": {
+						"type": "app_mention",
+						"user": "U061F7AUR",
+						"text": "<@U0LAN0Z89> 공휴일",
+						"ts": "1515449522.000016",
+						"channel": "C123ABC456",
+						"event_ts": "1515449522000016"
+					}
+				}
+			}`,
+			want: slack.InnerEventTypeAppMention,
+		},
+		{
+			desc: "message",
+			data: `{
+				"envelope_id": "d2a7b0a6-2f0f-4b0c-9a7a-6d2c2d3f9c11",
+				"type": "events_api",
+				"payload": {
+					"type": "event_callback",
+					"event": {
+						"type": "message",
+						"channel": "D024BE91L",
+						"channel_type": "im",
+						"user": "U2147483697",
+						"text": "날씨",
+						"ts": "1355517523.000005",
+						"event_ts": "1355517523.000005"
+					}
+				}
+			}`,
+			want: slack.InnerEventTypeMessage,
+		},
+		{
+			desc: "reaction_added",
+			data: `{
+				"envelope_id": "0b3f7a34-7a4e-4a8b-8d0e-9b3c1e2f4a55",
+				"type": "events_api",
+				"payload": {
+					"type": "event_callback",
+					"event": {
+						"type": "reaction_added",
+						"user": "U024BE7LH",
+						"reaction": "thumbsup",
+						"item_user": "U0G9QF9C6",
+						"item": {
+							"type": "message",
+							"channel": "C0G9QF9GZ",
+							"ts": "1360782400.498405"
+						},
+						"event_ts": "1360782804.083113"
+					}
+				}
+			}`,
+			want: slack.InnerEventTypeReactionAdded,
+		},
+		{
+			desc: "member_joined_channel",
+			data: `{
+				"envelope_id": "6f1f9a2d-0c55-4d8e-a3f1-0f6c5c1b2e77",
+				"type": "events_api",
+				"payload": {
+					"type": "event_callback",
+					"event": {
+						"type": "member_joined_channel",
+						"user": "W06GH7XHN",
+						"channel": "C0698JE0H",
+						"channel_type": "C",
+						"team": "T024BE7LD",
+						"inviter": "U123456789"
+					}
+				}
+			}`,
+			want: slack.InnerEventTypeMemberJoinedChannel,
+		},
+		{
+			desc: "undefined inner event",
+			data: `{
+				"envelope_id": "a1b2c3d4-0000-0000-0000-000000000000",
+				"type": "events_api",
+				"payload": {
+					"type": "event_callback",
+					"event": {
+						"type": "channel_created",
+						"channel": {"id": "C024BE91L"}
+					}
+				}
+			}`,
+			want: "channel_created",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			event, err := slack.UnmarshalSlackEvent([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			e, ok := event.(*slack.EventsAPIEvent)
+			if !ok {
+				t.Fatalf("expected *slack.EventsAPIEvent, got %T", event)
+			}
+			if e.EnvelopeID == "" {
+				t.Errorf("expected envelope id, got empty")
+			}
+			if e.Payload.Event == nil {
+				t.Fatalf("expected inner event, got nil")
+			}
+			if got := e.Payload.Event.InnerEventType(); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMessageEventIsDirectMessage(t *testing.T) {
+	data := `{
+		"type": "message",
+		"channel": "D024BE91L",
+		"channel_type": "im",
+		"user": "U2147483697",
+		"text": "기능",
+		"ts": "1355517523.000005",
+		"thread_ts": "1355517500.000001",
+		"event_ts": "1355517523.000050"
+	}`
+
+	event, err := slack.UnmarshalInnerEvent([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e, ok := event.(*slack.MessageEvent)
+	if !ok {
+		t.Fatalf("expected *slack.MessageEvent, got %T", event)
+	}
+	if !e.IsDirectMessage() {
+		t.Errorf("expected direct message")
+	}
+	if e.ThreadTimestamp != "1355517500.000001" {
+		t.Errorf("unexpected thread timestamp: %s", e.ThreadTimestamp)
+	}
+	// 끝자리의 0 도 그대로 유지해야 한다.
+	if e.EventTimestamp != "1355517523.000050" {
+		t.Errorf("unexpected event timestamp: %s", e.EventTimestamp)
+	}
+}
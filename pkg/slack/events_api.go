@@ -0,0 +1,231 @@
+package slack
+
+import (
+	"encoding/json"
+)
+
+// reference
+// https://api.slack.com/apis/events-api
+// https://api.slack.com/events
+
+// Events API 이벤트 발생시 전달받는 데이터.
+type EventsAPIPayload struct {
+	// The unique identifier of the workspace where the event occurred.
+	TeamID string `json:"team_id"`
+	// The unique identifier your installed Slack application.
+	AppID string `json:"api_app_id"`
+	// Indicates which kind of event dispatch this is, usually `event_callback`.
+	Type string `json:"type"`
+	// A unique identifier for this specific event, globally unique across all workspaces.
+	EventID string `json:"event_id"`
+	// (unix-timestamp seconds)
+	// The epoch timestamp in seconds indicating when this event was dispatched.
+	EventTime int `json:"event_time"`
+	// The actual event, an object that happened.
+	// 정의되지 않은 타입의 이벤트는 UndefinedInnerEvent 로 전달된다.
+	Event InnerEvent `json:"-"`
+}
+
+func (p *EventsAPIPayload) UnmarshalJSON(data []byte) error {
+	type payload EventsAPIPayload
+	raw := struct {
+		*payload
+		Event json.RawMessage `json:"event"`
+	}{
+		payload: (*payload)(p),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if len(raw.Event) == 0 {
+		p.Event = nil
+		return nil
+	}
+
+	event, err := UnmarshalInnerEvent(raw.Event)
+	if err != nil {
+		return err
+	}
+	p.Event = event
+
+	return nil
+}
+
+func (p *EventsAPIPayload) MarshalJSON() ([]byte, error) {
+	type payload EventsAPIPayload
+	raw := struct {
+		payload
+		Event InnerEvent `json:"event,omitempty"`
+	}{
+		payload: payload(*p),
+		Event:   p.Event,
+	}
+	return json.Marshal(raw)
+}
+
+type InnerEventType string
+
+const (
+	InnerEventTypeAppMention          InnerEventType = "app_mention"
+	InnerEventTypeMessage             InnerEventType = "message"
+	InnerEventTypeReactionAdded       InnerEventType = "reaction_added"
+	InnerEventTypeMemberJoinedChannel InnerEventType = "member_joined_channel"
+)
+
+// Events API 페이로드의 event 필드에 담겨 전달되는 이벤트.
+type InnerEvent interface {
+	InnerEventType() InnerEventType
+}
+
+// Subscribe to only the message events that mention your app or bot.
+type AppMentionEvent struct {
+	Type InnerEventType `json:"type"`
+	// The ID of the user who mentioned the app.
+	User string `json:"user"`
+	// The text of the message, including the mention. (e.g. `<@U0LAN0Z89> is it everything?`)
+	Text string `json:"text"`
+	// The timestamp of the message.
+	Timestamp string `json:"ts"`
+	// The timestamp of the parent message, if the mention was made in a thread.
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
+	// The ID of the channel where the app was mentioned.
+	Channel string `json:"channel"`
+	// The timestamp of the event.
+	EventTimestamp string `json:"event_ts"`
+}
+
+func (e *AppMentionEvent) InnerEventType() InnerEventType {
+	return e.Type
+}
+
+// A message was sent to a channel.
+type MessageEvent struct {
+	Type InnerEventType `json:"type"`
+	// 일반 메시지는 비어있으며, 메시지 수정/삭제나 봇 메시지 등은 subtype 으로 구분한다.
+	// (e.g. `message_changed`, `message_deleted`, `bot_message`)
+	SubType string `json:"subtype,omitempty"`
+	// The ID of the channel where the message was sent.
+	Channel string `json:"channel"`
+	// The type of channel. One of `channel`, `group`, `im`, `mpim`.
+	ChannelType string `json:"channel_type"`
+	// The ID of the user who sent the message.
+	User string `json:"user"`
+	// 봇이 보낸 메시지인 경우 봇의 ID.
+	BotID string `json:"bot_id,omitempty"`
+	// The text of the message.
+	Text string `json:"text"`
+	// The timestamp of the message.
+	Timestamp string `json:"ts"`
+	// The timestamp of the parent message, if the message was sent in a thread.
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
+	// The timestamp of the event.
+	EventTimestamp string `json:"event_ts"`
+}
+
+func (e *MessageEvent) InnerEventType() InnerEventType {
+	return e.Type
+}
+
+// 메시지가 사용자 간의 DM 또는 봇과의 DM 에서 전달되었는지 여부.
+func (e *MessageEvent) IsDirectMessage() bool {
+	return e.ChannelType == "im"
+}
+
+// 반응(이모지)이 추가된 대상.
+type ReactionItem struct {
+	// The type of item. One of `message`, `file`, `file_comment`.
+	Type string `json:"type"`
+	// The ID of the channel the message was posted in.
+	Channel string `json:"channel"`
+	// The timestamp of the message.
+	Timestamp string `json:"ts"`
+}
+
+// A member has added an emoji reaction to an item.
+type ReactionAddedEvent struct {
+	Type InnerEventType `json:"type"`
+	// The ID of the user who performed this event.
+	User string `json:"user"`
+	// The name of the reaction, without colons. (e.g. `thumbsup`)
+	Reaction string `json:"reaction"`
+	// The ID of the user that created the original item that has been reacted to.
+	ItemUser string `json:"item_user"`
+	// Contains information about the item that was reacted to.
+	Item ReactionItem `json:"item"`
+	// The timestamp of the event.
+	EventTimestamp string `json:"event_ts"`
+}
+
+func (e *ReactionAddedEvent) InnerEventType() InnerEventType {
+	return e.Type
+}
+
+// A user joined a public or private channel.
+type MemberJoinedChannelEvent struct {
+	Type InnerEventType `json:"type"`
+	// The ID of the user who joined the channel.
+	User string `json:"user"`
+	// The ID of the channel the user joined.
+	Channel string `json:"channel"`
+	// The type of channel. `C` for public channels, `G` for private channels.
+	ChannelType string `json:"channel_type"`
+	// The ID of the workspace the user belongs to.
+	Team string `json:"team"`
+	// The ID of the user who invited the user to the channel, if any.
+	Inviter string `json:"inviter,omitempty"`
+}
+
+func (e *MemberJoinedChannelEvent) InnerEventType() InnerEventType {
+	return e.Type
+}
+
+// 정의되지 않은 타입의 이벤트.
+// 구독한 이벤트 중 타입이 정의되지 않은 이벤트도 유실되지 않도록 원본 데이터를 보관한다.
+type UndefinedInnerEvent struct {
+	Type InnerEventType `json:"type"`
+	Data json.RawMessage
+}
+
+func (e *UndefinedInnerEvent) InnerEventType() InnerEventType {
+	return e.Type
+}
+
+func (e *UndefinedInnerEvent) MarshalJSON() ([]byte, error) {
+	return e.Data, nil
+}
+
+func UnmarshalInnerEvent(data []byte) (InnerEvent, error) {
+	var raw = struct {
+		Type InnerEventType `json:"type"`
+	}{}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var unmarshal = func(data []byte, value InnerEvent) (InnerEvent, error) {
+		if err := json.Unmarshal(data, value); err != nil {
+			return nil, err
+		} else {
+			return value, err
+		}
+	}
+
+	switch raw.Type {
+	case InnerEventTypeAppMention:
+		e := AppMentionEvent{}
+		return unmarshal(data, &e)
+	case InnerEventTypeMessage:
+		e := MessageEvent{}
+		return unmarshal(data, &e)
+	case InnerEventTypeReactionAdded:
+		e := ReactionAddedEvent{}
+		return unmarshal(data, &e)
+	case InnerEventTypeMemberJoinedChannel:
+		e := MemberJoinedChannelEvent{}
+		return unmarshal(data, &e)
+	default:
+		return &UndefinedInnerEvent{Type: raw.Type, Data: append(json.RawMessage(nil), data...)}, nil
+	}
+}
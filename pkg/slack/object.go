@@ -1,5 +1,9 @@
 package slack
 
+import (
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
 // A conversation object contains information about a channel-like thing in Slack.
 // It might be a public channel, a private channel, a direct message,
 // a multi-person direct message, or a huddle.
@@ -64,3 +68,52 @@ type ConversationObject struct {
 	// except to reply to messages in the channel.
 	IsThreatOnly bool `json:"is_thread_only"`
 }
+
+// A view object represents a modal or app home surface.
+// Slack fills in the view's identifiers and state when views are opened or submitted.
+type ViewObject struct {
+	// The view ID.
+	ID string `json:"id"`
+	// The workspace ID.
+	TeamID string `json:"team_id"`
+	// The type of the view. (e.g. `modal`)
+	Type blockkit.ViewType `json:"type"`
+	// The identifier set when the view was opened.
+	CallbackID string `json:"callback_id"`
+	// The private metadata set when the view was opened.
+	PrivateMetadata string `json:"private_metadata"`
+	// The custom identifier set when the view was opened.
+	ExternalID string `json:"external_id"`
+	// The values of input elements in the view, keyed by block_id and action_id.
+	State ViewState `json:"state"`
+	// A unique value which is optionally accepted in views.update to prevent race conditions.
+	Hash string `json:"hash"`
+	// The ID of the first view in the view stack.
+	RootViewID string `json:"root_view_id"`
+	// The ID of the view that this view was pushed on top of, if any.
+	PreviousViewID string `json:"previous_view_id"`
+	AppID          string `json:"app_id"`
+	BotID          string `json:"bot_id"`
+}
+
+// A message object contains information about a message posted to a conversation.
+type MessageObject struct {
+	// The type of message. Always "message".
+	Type string `json:"type"`
+	// The subtype of the message, if any. e.g. "bot_message", "channel_join".
+	Subtype string `json:"subtype,omitempty"`
+	// The ID of the app that posted the message, if any.
+	AppID string `json:"app_id,omitempty"`
+	// The ID of the bot that posted the message, if any.
+	BotID string `json:"bot_id,omitempty"`
+	// The ID of the user who posted the message.
+	User string `json:"user"`
+	// The text of the message.
+	Text string `json:"text"`
+	// The unique (per-channel) timestamp of the message.
+	Timestamp string `json:"ts"`
+	// The timestamp of the parent message, if the message is in a thread.
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
+	// The number of replies to the message, if it is a thread parent.
+	ReplyCount int `json:"reply_count,omitempty"`
+}
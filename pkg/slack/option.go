@@ -0,0 +1,61 @@
+package slack
+
+import (
+	"net/http"
+	"time"
+)
+
+type clientOptions struct {
+	baseURL     string
+	httpClient  *http.Client
+	userAgent   string
+	hooks       []func(req *http.Request)
+	rateLimiter *RateLimiter
+	observe     func(method string, status string, duration time.Duration)
+}
+
+type Option func(*clientOptions)
+
+// 슬랙 Web API 대신 요청을 보낼 주소를 지정한다. 테스트용 서버를 사용할 때 지정한다.
+// e.g. "http://127.0.0.1:8080/api"
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+func WithHTTPClient(client http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = &client
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// 요청을 보내기 직전에 호출할 함수를 추가한다. 여러 번 지정하면 추가한 순서대로 호출한다.
+func WithRequestHook(hook func(req *http.Request)) Option {
+	return func(o *clientOptions) {
+		o.hooks = append(o.hooks, hook)
+	}
+}
+
+// 여러 클라이언트가 요청 제한을 함께 관리하도록 RateLimiter 를 지정한다.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.rateLimiter = limiter
+	}
+}
+
+// Web API 호출이 끝날 때마다 호출할 함수를 지정한다. 지표를 수집할 때 사용한다.
+// method 는 API 메서드(response_url 로 응답한 경우 "response_url"),
+// status 는 성공하면 "ok", 슬랙이 오류를 반환하면 오류 코드, 요청 제한에 걸리면 "ratelimited",
+// 그 외의 오류는 "error" 이다. duration 은 재시도를 포함한 전체 시간이다.
+func WithCallObserver(observe func(method string, status string, duration time.Duration)) Option {
+	return func(o *clientOptions) {
+		o.observe = observe
+	}
+}
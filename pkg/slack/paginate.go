@@ -0,0 +1,128 @@
+package slack
+
+import (
+	"context"
+	"iter"
+)
+
+// 커서 기반 페이지네이션 API 를 끝까지 따라가며 항목을 하나씩 반환하는 이터레이터를 만든다.
+// fetch 는 주어진 커서의 항목과 다음 페이지의 커서를 반환하며, 오류가 발생하면 순회를 멈춘다.
+func paginate[T any](cursor string, fetch func(cursor string) ([]T, string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			// 같은 커서가 반복되면 무한히 요청하지 않도록 멈춘다.
+			if next == "" || next == cursor {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// 이터레이터의 항목을 최대 limit 개까지 모은다. limit 이 0 이하이면 모든 항목을 모은다.
+// 순회 중 오류가 발생하면 그때까지 모은 항목과 함께 오류를 반환한다.
+func Collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	var items []T
+	if limit > 0 {
+		items = make([]T, 0, limit)
+	}
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+	}
+	return items, nil
+}
+
+// 채널 목록을 모든 페이지에 걸쳐 순회한다.
+func (c *Client) Channels(ctx context.Context, req *ListChannelsRequest) iter.Seq2[ConversationObject, error] {
+	r := ListChannelsRequest{}
+	if req != nil {
+		r = *req
+	}
+	return paginate(r.Cursor, func(cursor string) ([]ConversationObject, string, error) {
+		r.Cursor = cursor
+		resp, err := c.ListChannels(ctx, &r)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Channels, resp.Metadata.NextCursor, nil
+	})
+}
+
+// 채널의 메시지 목록을 모든 페이지에 걸쳐 순회한다.
+func (c *Client) Messages(ctx context.Context, req *ListMessagesRequest) iter.Seq2[MessageObject, error] {
+	r := *req
+	return paginate(r.Cursor, func(cursor string) ([]MessageObject, string, error) {
+		r.Cursor = cursor
+		resp, err := c.ListMessages(ctx, &r)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Messages, resp.Metadata.NextCursor, nil
+	})
+}
+
+// 스레드의 메시지 목록을 모든 페이지에 걸쳐 순회한다.
+func (c *Client) Replies(ctx context.Context, req *ListRepliesRequest) iter.Seq2[MessageObject, error] {
+	r := *req
+	return paginate(r.Cursor, func(cursor string) ([]MessageObject, string, error) {
+		r.Cursor = cursor
+		resp, err := c.ListReplies(ctx, &r)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Messages, resp.Metadata.NextCursor, nil
+	})
+}
+
+// 워크스페이스의 사용자 목록을 모든 페이지에 걸쳐 순회한다.
+func (c *Client) Users(ctx context.Context, req *ListUsersRequest) iter.Seq2[User, error] {
+	r := ListUsersRequest{}
+	if req != nil {
+		r = *req
+	}
+	return paginate(r.Cursor, func(cursor string) ([]User, string, error) {
+		r.Cursor = cursor
+		resp, err := c.ListUsers(ctx, &r)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Members, resp.Metadata.NextCursor, nil
+	})
+}
+
+// 모든 페이지의 채널을 최대 limit 개까지 조회한다. limit 이 0 이하이면 모든 채널을 조회한다.
+func (c *Client) ListAllChannels(ctx context.Context, req *ListChannelsRequest, limit int) ([]ConversationObject, error) {
+	return Collect(c.Channels(ctx, req), limit)
+}
+
+// 모든 페이지의 메시지를 최대 limit 개까지 조회한다. limit 이 0 이하이면 모든 메시지를 조회한다.
+func (c *Client) ListAllMessages(ctx context.Context, req *ListMessagesRequest, limit int) ([]MessageObject, error) {
+	return Collect(c.Messages(ctx, req), limit)
+}
+
+// 모든 페이지의 스레드 메시지를 최대 limit 개까지 조회한다. limit 이 0 이하이면 모든 메시지를 조회한다.
+func (c *Client) ListAllReplies(ctx context.Context, req *ListRepliesRequest, limit int) ([]MessageObject, error) {
+	return Collect(c.Replies(ctx, req), limit)
+}
+
+// 모든 페이지의 사용자를 최대 limit 개까지 조회한다. limit 이 0 이하이면 모든 사용자를 조회한다.
+func (c *Client) ListAllUsers(ctx context.Context, req *ListUsersRequest, limit int) ([]User, error) {
+	return Collect(c.Users(ctx, req), limit)
+}
@@ -0,0 +1,124 @@
+package slack_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func sequence(items []int, err error) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	errFetch := errors.New("ratelimited")
+
+	testCases := []struct {
+		desc    string
+		seq     iter.Seq2[int, error]
+		limit   int
+		want    []int
+		wantErr error
+	}{
+		{
+			desc:  "all items",
+			seq:   sequence([]int{1, 2, 3}, nil),
+			limit: 0,
+			want:  []int{1, 2, 3},
+		},
+		{
+			desc:  "limited items",
+			seq:   sequence([]int{1, 2, 3}, nil),
+			limit: 2,
+			want:  []int{1, 2},
+		},
+		{
+			desc:  "limit larger than items",
+			seq:   sequence([]int{1}, nil),
+			limit: 10,
+			want:  []int{1},
+		},
+		{
+			desc:    "error after items",
+			seq:     sequence([]int{1, 2}, errFetch),
+			limit:   0,
+			want:    []int{1, 2},
+			wantErr: errFetch,
+		},
+		{
+			desc:  "limit reached before error",
+			seq:   sequence([]int{1, 2}, errFetch),
+			limit: 2,
+			want:  []int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := slack.Collect(tc.seq, tc.limit)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// 슬랙의 next_cursor 는 base64 이므로 +, /, = 가 포함될 수 있다.
+func TestChannelsEscapedCursor(t *testing.T) {
+	pages := map[string]struct {
+		channel string
+		next    string
+	}{
+		"":                         {channel: "C1", next: "dXNlcjpVMEc5V0ZYTlo+/w=="},
+		"dXNlcjpVMEc5V0ZYTlo+/w==": {channel: "C2", next: "dGVhbTpDMDYxRkE1UEI+/9="},
+		"dGVhbTpDMDYxRkE1UEI+/9=":  {channel: "C3"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Query().Get("cursor")]
+		if !ok {
+			_, _ = w.Write([]byte(`{"ok": false, "error": "invalid_cursor"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(slack.ListChannelsResponse{
+			APIResponse: slack.APIResponse{OK: true},
+			Channels:    []slack.ConversationObject{{ID: page.channel}},
+			Metadata:    slack.ResponseMetadata{NextCursor: page.next},
+		})
+	}))
+	defer server.Close()
+
+	client := slack.NewClient("", "xoxb-test", slack.WithBaseURL(server.URL))
+	channels, err := client.ListAllChannels(context.Background(), &slack.ListChannelsRequest{
+		Limit: 1,
+		Types: slack.PublicChannel + "," + slack.PrivateChannel,
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var ids []string
+	for _, channel := range channels {
+		ids = append(ids, channel.ID)
+	}
+	if want := []string{"C1", "C2", "C3"}; !slices.Equal(ids, want) {
+		t.Errorf("expected %v, got %v", want, ids)
+	}
+}
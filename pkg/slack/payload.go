@@ -1,12 +1,16 @@
 package slack
 
 import (
+	"time"
+
 	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
 )
 
 // reference
 // https://api.slack.com/interactivity/slash-commands
 // https://api.slack.com/reference/interaction-payloads/block-actions
+// https://api.slack.com/reference/interaction-payloads/views
+// https://api.slack.com/reference/interaction-payloads/shortcuts
 
 // 슬래시 커맨드 이벤트 발생시 전달받는 데이터.
 type SlashCommandEventPayload struct {
@@ -41,12 +45,29 @@ type InteractiveUser struct {
 	TeamID   string `json:"team_id"`
 }
 
+type InteractionType string
+
+const (
+	// 블록에 포함된 상호작용 요소를 사용한 경우.
+	InteractionTypeBlockActions InteractionType = "block_actions"
+	// 모달의 제출 버튼을 누른 경우.
+	InteractionTypeViewSubmission InteractionType = "view_submission"
+	// 모달의 닫기 버튼을 누른 경우. 모달을 열 때 notify_on_close 를 설정해야 전달된다.
+	InteractionTypeViewClosed InteractionType = "view_closed"
+	// external_select 요소에 사용자가 입력한 경우. 응답으로 옵션 목록을 전달해야 한다.
+	InteractionTypeBlockSuggestion InteractionType = "block_suggestion"
+	// 검색창이나 메시지 작성창의 바로가기 메뉴에서 전역 바로가기를 실행한 경우.
+	InteractionTypeShortcut InteractionType = "shortcut"
+	// 메시지의 더보기 메뉴에서 메시지 바로가기를 실행한 경우.
+	InteractionTypeMessageAction InteractionType = "message_action"
+)
+
 // 상호작용 이벤트 발생시 전달받는 데이터.
 type InteractiveEventPayload struct {
 	// Helps identify which type of interactive component sent the payload.
 	// An interactive element in a block will have a type of `block_actions`,
 	// whereas an interactive element in a message attachment will have a type of `interactive_message`.
-	Type string `json:"type"`
+	Type InteractionType `json:"type"`
 	// A short-lived ID that can be used to open modals.
 	TriggerID string `json:"trigger_id"`
 	// 상호작용 이벤트를 발생시킨 사용자 정보.
@@ -59,6 +80,36 @@ type InteractiveEventPayload struct {
 	Actions []InteractiveAction `json:"actions"`
 	// A short-lived webhook that can be used to send messages in response to interactions.
 	ResponseURL string `json:"response_url"`
+	// The source view of the modal that the user submitted or closed,
+	// or that contains the interactive component that was used.
+	View *ViewObject `json:"view,omitempty"`
+	// view_closed 이벤트에서 모달의 모든 뷰가 닫혔는지 여부.
+	IsCleared bool `json:"is_cleared,omitempty"`
+	// block_suggestion 이벤트에서 옵션을 요청한 요소의 action_id.
+	ActionID string `json:"action_id,omitempty"`
+	// block_suggestion 이벤트에서 옵션을 요청한 요소의 block_id.
+	BlockID string `json:"block_id,omitempty"`
+	// block_suggestion 이벤트에서 사용자가 입력한 값.
+	Value string `json:"value,omitempty"`
+	// An ID that you defined when creating the shortcut.
+	// shortcut, message_action 이벤트에서 실행한 바로가기를 구분한다.
+	CallbackID string `json:"callback_id,omitempty"`
+	// shortcut, message_action 이벤트에서 바로가기를 실행한 시간.
+	ActionTimestamp string `json:"action_ts,omitempty"`
+	// message_action 이벤트에서 바로가기를 실행한 메시지.
+	Message *MessageObject `json:"message,omitempty"`
+}
+
+// 메시지 바로가기를 실행한 메시지가 속한 스레드의 ts.
+// 스레드의 답글이면 부모 메시지의 ts, 그 외에는 메시지의 ts 를 반환한다. 메시지가 없으면 빈 문자열이다.
+func (p *InteractiveEventPayload) ThreadTimestamp() string {
+	if p.Message == nil {
+		return ""
+	}
+	if p.Message.ThreadTimestamp != "" {
+		return p.Message.ThreadTimestamp
+	}
+	return p.Message.Timestamp
 }
 
 type InteractiveContainer struct {
@@ -70,12 +121,85 @@ type InteractiveContainer struct {
 
 // 상호작용 이벤트를 발생시킨 액션에 대한 데이터.
 type InteractiveAction struct {
-	Type           blockkit.ElementType      `json:"type"`
-	Timestamp      float64                   `json:"action_ts,string"`
-	ActionID       string                    `json:"action_id"`
-	BlockID        string                    `json:"block_id"`
-	Value          string                    `json:"value"`
-	SelectedOption InteractiveSelectedOption `json:"selected_option"`
+	Type      blockkit.ElementType `json:"type"`
+	Timestamp string               `json:"action_ts"`
+	ActionID  string               `json:"action_id"`
+	BlockID   string               `json:"block_id"`
+
+	// 요소 타입에 따라 사용자가 입력하거나 선택한 값.
+	SelectedValues
+}
+
+// 상호작용 요소에서 사용자가 입력하거나 선택한 값.
+// 요소 타입에 해당하는 필드만 채워진다.
+type SelectedValues struct {
+	// button, plain_text_input, email_text_input, url_text_input, number_input 요소의 값.
+	Value string `json:"value,omitempty"`
+	// static_select, external_select, radio_buttons, overflow 요소에서 선택된 값.
+	SelectedOption *InteractiveSelectedOption `json:"selected_option,omitempty"`
+	// multi_static_select, multi_external_select, checkboxes 요소에서 선택된 값.
+	SelectedOptions []InteractiveSelectedOption `json:"selected_options,omitempty"`
+	// datepicker 요소에서 선택된 날짜. (YYYY-MM-DD)
+	SelectedDate string `json:"selected_date,omitempty"`
+	// timepicker 요소에서 선택된 시간. (HH:mm)
+	SelectedTime string `json:"selected_time,omitempty"`
+	// (unix-timestamp seconds)
+	// datetimepicker 요소에서 선택된 일시.
+	SelectedDateTime int64 `json:"selected_date_time,omitempty"`
+	// users_select 요소에서 선택된 사용자 ID.
+	SelectedUser string `json:"selected_user,omitempty"`
+	// multi_users_select 요소에서 선택된 사용자 ID 목록.
+	SelectedUsers []string `json:"selected_users,omitempty"`
+	// channels_select 요소에서 선택된 채널 ID.
+	SelectedChannel string `json:"selected_channel,omitempty"`
+	// multi_channels_select 요소에서 선택된 채널 ID 목록.
+	SelectedChannels []string `json:"selected_channels,omitempty"`
+	// conversations_select 요소에서 선택된 대화 ID.
+	SelectedConversation string `json:"selected_conversation,omitempty"`
+	// multi_conversations_select 요소에서 선택된 대화 ID 목록.
+	SelectedConversations []string `json:"selected_conversations,omitempty"`
+}
+
+// datepicker 요소에서 선택된 날짜를 주어진 지역의 자정으로 변환한다.
+// 선택된 날짜가 없으면 false 를 반환한다.
+func (v *SelectedValues) Date(loc *time.Location) (time.Time, bool) {
+	t, err := time.ParseInLocation(time.DateOnly, v.SelectedDate, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// timepicker 요소에서 선택된 시간을 주어진 날짜의 시각으로 변환한다.
+// 선택된 시간이 없으면 false 를 반환한다.
+func (v *SelectedValues) Time(date time.Time) (time.Time, bool) {
+	t, err := time.Parse("15:04", v.SelectedTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+	year, month, day := date.Date()
+	return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, date.Location()), true
+}
+
+// datetimepicker 요소에서 선택된 일시를 반환한다.
+// 선택된 일시가 없으면 false 를 반환한다.
+func (v *SelectedValues) DateTime() (time.Time, bool) {
+	if v.SelectedDateTime == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(v.SelectedDateTime, 0), true
+}
+
+// 선택된 옵션들의 값 목록을 반환한다.
+func (v *SelectedValues) OptionValues() []string {
+	if v.SelectedOption != nil {
+		return []string{v.SelectedOption.Value}
+	}
+	values := make([]string, 0, len(v.SelectedOptions))
+	for _, option := range v.SelectedOptions {
+		values = append(values, option.Value)
+	}
+	return values
 }
 
 type InteractiveChannel struct {
@@ -106,7 +230,7 @@ type InteractiveResponsePayload struct {
 	// blocks 필드에 문제가 있는 경우 대신 표시되므로 사용하는 것이 권장된다.
 	Text string `json:"text"`
 	// text 필드를 사용하지 않을 경우에는 이 필드를 메시지의 본문으로 사용한다.
-	Blocks []blockkit.SlackBlock `json:"blocks,omitempty"`
+	Blocks blockkit.Blocks `json:"blocks,omitempty"`
 	// The ID of another un-threaded message to reply to.
 	ThreadTimestamp string `json:"thread_ts,omitempty"`
 	// 원본 메시지를 삭제할지 여부를 결정한다.
@@ -114,3 +238,58 @@ type InteractiveResponsePayload struct {
 	// 원본 메시지를 대체할지 여부를 결정한다.
 	ReplaceOriginal bool `json:"replace_original"`
 }
+
+type ViewResponseAction string
+
+const (
+	// 입력 요소 아래에 오류를 표시하고 모달을 닫지 않는다.
+	ViewResponseActionErrors ViewResponseAction = "errors"
+	// 제출한 뷰를 새로운 뷰로 대체한다.
+	ViewResponseActionUpdate ViewResponseAction = "update"
+	// 제출한 뷰 위에 새로운 뷰를 쌓는다.
+	ViewResponseActionPush ViewResponseAction = "push"
+	// 모달의 모든 뷰를 닫는다.
+	ViewResponseActionClear ViewResponseAction = "clear"
+)
+
+// view_submission 이벤트에 소켓으로 즉시 응답하는 데이터.
+type ViewSubmissionResponse struct {
+	ResponseAction ViewResponseAction `json:"response_action"`
+	// response_action 이 errors 인 경우 block_id 를 키로 하는 오류 메시지.
+	Errors map[string]string `json:"errors,omitempty"`
+	// response_action 이 update 또는 push 인 경우 표시할 뷰.
+	View *blockkit.ModalView `json:"view,omitempty"`
+}
+
+// block_suggestion 이벤트에 소켓으로 즉시 응답하는 데이터.
+// options 와 option_groups 중 하나만 사용한다.
+type BlockSuggestionResponse struct {
+	// Maximum of 100 options.
+	Options []blockkit.OptionBlockObject `json:"options,omitempty"`
+	// Maximum of 100 option groups.
+	OptionGroups []blockkit.OptionGroupObject `json:"option_groups,omitempty"`
+}
+
+// 모달에 포함된 입력 요소의 값.
+type ViewState struct {
+	// block_id 와 action_id 를 키로 하는 입력 요소의 값.
+	Values map[string]map[string]ViewStateValue `json:"values"`
+}
+
+// 주어진 block_id, action_id 에 해당하는 입력 요소의 값을 반환한다.
+func (s *ViewState) Value(blockID, actionID string) (ViewStateValue, bool) {
+	actions, ok := s.Values[blockID]
+	if !ok {
+		return ViewStateValue{}, false
+	}
+	value, ok := actions[actionID]
+	return value, ok
+}
+
+// 모달의 입력 요소에 사용자가 입력하거나 선택한 값.
+type ViewStateValue struct {
+	Type blockkit.ElementType `json:"type"`
+
+	// 요소 타입에 따라 사용자가 입력하거나 선택한 값.
+	SelectedValues
+}
@@ -0,0 +1,195 @@
+package slack_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/kst"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func TestUnmarshalViewSubmission(t *testing.T) {
+	data := `{
+		"envelope_id": "b0d3f2a8-7d1e-4f9e-9c55-2e1f0f9d8a10",
+		"type": "interactive",
+		"accepts_response_payload": true,
+		"payload": {
+			"type": "view_submission",
+			"trigger_id": "12466734323.1395872398.f36f3b5d2c4e",
+			"user": {"id": "U0CA5", "username": "amy", "name": "amy", "team_id": "T3MDE"},
+			"view": {
+				"id": "VNHU13V36",
+				"type": "modal",
+				"callback_id": "leave_request",
+				"private_metadata": "C123ABC456",
+				"hash": "156663117.cd33ad1f",
+				"state": {
+					"values": {
+						"reason_block": {
+							"reason_input": {"type": "plain_text_input", "value": "병원 방문"}
+						},
+						"kind_block": {
+							"kind_select": {
+								"type": "static_select",
+								"selected_option": {
+									"text": {"type": "plain_text", "text": "반차"},
+									"value": "half"
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	event, err := slack.UnmarshalSlackEvent([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e, ok := event.(*slack.InteractiveEvent)
+	if !ok {
+		t.Fatalf("expected *slack.InteractiveEvent, got %T", event)
+	}
+	if e.Payload.Type != slack.InteractionTypeViewSubmission {
+		t.Fatalf("expected %q, got %q", slack.InteractionTypeViewSubmission, e.Payload.Type)
+	}
+	if e.Payload.View == nil {
+		t.Fatalf("expected view, got nil")
+	}
+	if e.Payload.View.CallbackID != "leave_request" {
+		t.Errorf("unexpected callback id: %s", e.Payload.View.CallbackID)
+	}
+
+	reason, ok := e.Payload.View.State.Value("reason_block", "reason_input")
+	if !ok || reason.Value != "병원 방문" {
+		t.Errorf("unexpected reason: %+v", reason)
+	}
+	kind, ok := e.Payload.View.State.Value("kind_block", "kind_select")
+	if !ok || kind.SelectedOption == nil || kind.SelectedOption.Value != "half" {
+		t.Errorf("unexpected kind: %+v", kind)
+	}
+	if _, ok := e.Payload.View.State.Value("kind_block", "undefined"); ok {
+		t.Errorf("expected no value for undefined action")
+	}
+}
+
+func TestUnmarshalInteractiveActionSelectedValues(t *testing.T) {
+	data := `{
+		"type": "block_actions",
+		"actions": [
+			{"type": "datepicker", "action_id": "date", "block_id": "b", "selected_date": "2026-03-01", "action_ts": "1700000000.000001"},
+			{"type": "timepicker", "action_id": "time", "block_id": "b", "selected_time": "09:30", "action_ts": "1700000000.000002"},
+			{"type": "datetimepicker", "action_id": "datetime", "block_id": "b", "selected_date_time": 1767225600, "action_ts": "1700000000.000003"},
+			{"type": "multi_users_select", "action_id": "users", "block_id": "b", "selected_users": ["U1", "U2"], "action_ts": "1700000000.000004"},
+			{"type": "checkboxes", "action_id": "checks", "block_id": "b", "selected_options": [
+				{"text": {"type": "plain_text", "text": "서울"}, "value": "seoul"},
+				{"text": {"type": "plain_text", "text": "부산"}, "value": "busan"}
+			], "action_ts": "1700000000.000005"},
+			{"type": "static_select", "action_id": "select", "block_id": "b", "selected_option": {"text": {"type": "plain_text", "text": "서울"}, "value": "seoul"}, "action_ts": "1700000000.000006"}
+		]
+	}`
+
+	payload := &slack.InteractiveEventPayload{}
+	if err := json.Unmarshal([]byte(data), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payload.Actions) != 6 {
+		t.Fatalf("expected 6 actions, got %d", len(payload.Actions))
+	}
+
+	date, ok := payload.Actions[0].Date(kst.Zone)
+	if !ok || !date.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, kst.Zone)) {
+		t.Errorf("unexpected date: %v", date)
+	}
+	at, ok := payload.Actions[1].Time(date)
+	if !ok || at.Hour() != 9 || at.Minute() != 30 || at.Day() != 1 {
+		t.Errorf("unexpected time: %v", at)
+	}
+	datetime, ok := payload.Actions[2].DateTime()
+	if !ok || datetime.Unix() != 1767225600 {
+		t.Errorf("unexpected date time: %v", datetime)
+	}
+	if got := payload.Actions[3].SelectedUsers; len(got) != 2 || got[0] != "U1" {
+		t.Errorf("unexpected users: %v", got)
+	}
+	if got := payload.Actions[4].OptionValues(); len(got) != 2 || got[1] != "busan" {
+		t.Errorf("unexpected options: %v", got)
+	}
+	if got := payload.Actions[5].OptionValues(); len(got) != 1 || got[0] != "seoul" {
+		t.Errorf("unexpected option: %v", got)
+	}
+	if _, ok := payload.Actions[5].Date(kst.Zone); ok {
+		t.Errorf("expected no date for static_select")
+	}
+}
+
+func TestUnmarshalShortcuts(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		data           string
+		wantType       slack.InteractionType
+		wantCallbackID string
+		wantThread     string
+	}{
+		{
+			desc: "global shortcut",
+			data: `{
+				"type": "shortcut",
+				"token": "XXXXXXXXXXXXX",
+				"action_ts": "1581106241.371594",
+				"team": {"id": "TXXXXXXXX", "domain": "shortcuts-test"},
+				"user": {"id": "UXXXXXXXXX", "username": "aman", "team_id": "TXXXXXXXX"},
+				"callback_id": "jarvis_menu",
+				"trigger_id": "944799105734.773906753841.38b5894552bdd4a780554ee59d1f3638"
+			}`,
+			wantType:       slack.InteractionTypeShortcut,
+			wantCallbackID: "jarvis_menu",
+		},
+		{
+			desc: "message shortcut in thread",
+			data: `{
+				"type": "message_action",
+				"callback_id": "remind_message",
+				"trigger_id": "13345224609.8534564800.6f8ab1f53e13d0cd15f96106292d5536",
+				"response_url": "https://hooks.slack.com/app-actions/T0MJR11A4/21974584944/yk1S9ndf35Q1flupVG5JbpM6",
+				"action_ts": "1581106241.371594",
+				"message_ts": "1548261231.000300",
+				"channel": {"id": "D0LFFBKLZ", "name": "cats"},
+				"user": {"id": "U0D15K92L", "name": "dr_maomao"},
+				"message": {
+					"type": "message",
+					"user": "U0MJRG1AL",
+					"text": "내일 회의 자료 확인 부탁드립니다",
+					"ts": "1548261231.000300",
+					"thread_ts": "1548261000.000100"
+				}
+			}`,
+			wantType:       slack.InteractionTypeMessageAction,
+			wantCallbackID: "remind_message",
+			wantThread:     "1548261000.000100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			payload := &slack.InteractiveEventPayload{}
+			if err := json.Unmarshal([]byte(tc.data), payload); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.Type != tc.wantType || payload.CallbackID != tc.wantCallbackID {
+				t.Errorf("unexpected shortcut: %s %s", payload.Type, payload.CallbackID)
+			}
+			if payload.ActionTimestamp != "1581106241.371594" {
+				t.Errorf("unexpected action ts: %v", payload.ActionTimestamp)
+			}
+			if got := payload.ThreadTimestamp(); got != tc.wantThread {
+				t.Errorf("expected thread ts %v, got %v", tc.wantThread, got)
+			}
+			if tc.wantType == slack.InteractionTypeMessageAction && payload.Message.User != "U0MJRG1AL" {
+				t.Errorf("unexpected message: %+v", payload.Message)
+			}
+		})
+	}
+}
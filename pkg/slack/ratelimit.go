@@ -0,0 +1,221 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 슬랙 Web API 의 메서드별 요청 제한 등급.
+// https://docs.slack.dev/apis/web-api/rate-limits
+type RateLimitTier int
+
+const (
+	// 분당 1회 이상.
+	RateLimitTier1 RateLimitTier = iota + 1
+	// 분당 20회 이상.
+	RateLimitTier2
+	// 분당 50회 이상.
+	RateLimitTier3
+	// 분당 100회 이상.
+	RateLimitTier4
+)
+
+type rateLimit struct {
+	perMinute int
+	burst     int
+}
+
+var tierLimits = map[RateLimitTier]rateLimit{
+	// 소켓 모드 재연결처럼 드물게 몰리는 요청은 허용된다.
+	RateLimitTier1: {perMinute: 1, burst: 3},
+	RateLimitTier2: {perMinute: 20, burst: 5},
+	RateLimitTier3: {perMinute: 50, burst: 10},
+	RateLimitTier4: {perMinute: 100, burst: 20},
+}
+
+// 메시지 게시는 등급 대신 채널마다 초당 1개로 제한된다.
+var channelPostLimit = rateLimit{perMinute: 60, burst: 1}
+
+// 등급이 정해지지 않은 메서드는 Retry-After 동안만 멈추도록 넉넉한 제한을 둔다.
+var pausedLimit = rateLimit{perMinute: 600, burst: 100}
+
+var methodTiers = map[string]RateLimitTier{
+	"apps.connections.open": RateLimitTier1,
+	"chat.delete":           RateLimitTier3,
+	"chat.postEphemeral":    RateLimitTier4,
+	"chat.scheduleMessage":  RateLimitTier3,
+	"chat.update":           RateLimitTier3,
+	"conversations.list":    RateLimitTier2,
+	"conversations.history": RateLimitTier3,
+	"conversations.replies": RateLimitTier3,
+	"usergroups.list":       RateLimitTier2,
+	"usergroups.users.list": RateLimitTier2,
+	"users.info":            RateLimitTier4,
+	"users.list":            RateLimitTier2,
+	"users.lookupByEmail":   RateLimitTier3,
+	"users.profile.get":     RateLimitTier4,
+	"views.open":            RateLimitTier4,
+	"views.push":            RateLimitTier4,
+	"views.update":          RateLimitTier4,
+}
+
+// 요청 제한에 걸려 재시도를 포기한 경우 반환되는 오류.
+type RateLimitedError struct {
+	// 요청 제한에 걸린 API 메서드.
+	Method string
+	// 슬랙이 Retry-After 헤더로 알려준 대기 시간.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("slack: rate limited on %s, retry after %s", e.Method, e.RetryAfter)
+}
+
+func (e *RateLimitedError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Retry-After 헤더의 초 단위 값을 읽는다. 값이 없거나 잘못된 경우 1초로 간주한다.
+func parseRetryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// 메서드 등급과 채널별 게시 제한을 로컬에서 지키도록 요청 시점을 조절한다.
+// 여러 고루틴에서 동시에 사용할 수 있으며, 같은 토큰을 쓰는 요청은 하나의 RateLimiter 를 공유해야 한다.
+type RateLimiter struct {
+	mu       sync.Mutex
+	methods  map[string]*tokenBucket
+	channels map[string]*tokenBucket
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		methods:  make(map[string]*tokenBucket),
+		channels: make(map[string]*tokenBucket),
+	}
+}
+
+// 메서드의 등급에 따라 요청할 수 있을 때까지 기다린다. 등급이 정해지지 않은 메서드는 기다리지 않는다.
+func (l *RateLimiter) Wait(ctx context.Context, method string) error {
+	bucket := l.methodBucket(method)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.wait(ctx)
+}
+
+// 채널에 메시지를 게시할 수 있을 때까지 기다린다.
+func (l *RateLimiter) WaitChannel(ctx context.Context, channel string) error {
+	return l.channelBucket(channel).wait(ctx)
+}
+
+// 슬랙으로부터 요청 제한 응답을 받은 경우 해당 메서드의 요청을 d 동안 멈춘다.
+func (l *RateLimiter) Pause(method string, d time.Duration) {
+	l.mu.Lock()
+	bucket, ok := l.methods[method]
+	if !ok {
+		limit := pausedLimit
+		if tier, ok := methodTiers[method]; ok {
+			limit = tierLimits[tier]
+		}
+		bucket = newTokenBucket(limit)
+		l.methods[method] = bucket
+	}
+	l.mu.Unlock()
+
+	bucket.pause(time.Now().Add(d))
+}
+
+func (l *RateLimiter) methodBucket(method string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if bucket, ok := l.methods[method]; ok {
+		return bucket
+	}
+	tier, ok := methodTiers[method]
+	if !ok {
+		return nil
+	}
+	bucket := newTokenBucket(tierLimits[tier])
+	l.methods[method] = bucket
+	return bucket
+}
+
+func (l *RateLimiter) channelBucket(channel string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.channels[channel]
+	if !ok {
+		bucket = newTokenBucket(channelPostLimit)
+		l.channels[channel] = bucket
+	}
+	return bucket
+}
+
+// GCRA 방식으로 구현한 토큰 버킷.
+// 다음 요청이 도착해야 하는 이론적인 시각(tat)만 기록한다.
+type tokenBucket struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	tolerance time.Duration
+	tat       time.Time
+}
+
+func newTokenBucket(limit rateLimit) *tokenBucket {
+	interval := time.Minute / time.Duration(limit.perMinute)
+	return &tokenBucket{
+		interval:  interval,
+		tolerance: interval * time.Duration(limit.burst-1),
+	}
+}
+
+// 토큰 하나를 예약하고 사용할 수 있을 때까지 남은 시간을 반환한다.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tat.Before(now) {
+		b.tat = now
+	}
+	allowAt := b.tat.Add(-b.tolerance)
+	b.tat = b.tat.Add(b.interval)
+	if allowAt.After(now) {
+		return allowAt.Sub(now)
+	}
+	return 0
+}
+
+func (b *tokenBucket) pause(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if tat := until.Add(b.tolerance); tat.After(b.tat) {
+		b.tat = tat
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package slack_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func TestRateLimiterWaitChannel(t *testing.T) {
+	limiter := slack.NewRateLimiter()
+
+	if err := limiter.WaitChannel(context.Background(), "C1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 다른 채널은 서로의 제한에 영향을 주지 않는다.
+	if err := limiter.WaitChannel(context.Background(), "C2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.WaitChannel(ctx, "C1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		method   string
+		requests int
+		wantErr  bool
+	}{
+		{
+			desc:     "tier 1 allows small burst",
+			method:   "apps.connections.open",
+			requests: 3,
+		},
+		{
+			desc:     "tier 1 blocks after burst",
+			method:   "apps.connections.open",
+			requests: 4,
+			wantErr:  true,
+		},
+		{
+			desc:     "tier 4 allows burst",
+			method:   "users.profile.get",
+			requests: 20,
+		},
+		{
+			desc:     "undefined method is not limited",
+			method:   "chat.postMessage",
+			requests: 1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			limiter := slack.NewRateLimiter()
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			var err error
+			for range tc.requests {
+				if err = limiter.Wait(ctx, tc.method); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRateLimiterPause(t *testing.T) {
+	limiter := slack.NewRateLimiter()
+	limiter.Pause("chat.postMessage", time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, "chat.postMessage"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
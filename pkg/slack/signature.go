@@ -0,0 +1,61 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// reference
+// https://api.slack.com/authentication/verifying-requests-from-slack
+
+const (
+	signatureVersion = "v0"
+	// 요청의 타임스탬프가 현재 시각과 이 시간 이상 차이나면 재전송 공격으로 판단한다.
+	SignatureMaxAge = 5 * time.Minute
+
+	HeaderSignature = "X-Slack-Signature"
+	HeaderTimestamp = "X-Slack-Request-Timestamp"
+)
+
+var (
+	ErrMissingSignature = errors.New("slack: missing request signature")
+	ErrInvalidSignature = errors.New("slack: invalid request signature")
+	ErrExpiredTimestamp = errors.New("slack: request timestamp is out of range")
+)
+
+// 요청 본문과 타임스탬프로 슬랙 요청 서명을 만든다. (e.g. `v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503`)
+func ComputeSignature(signingSecret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte(signatureVersion + ":" + strconv.FormatInt(timestamp, 10) + ":"))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// 슬랙이 보낸 요청인지 서명으로 확인한다.
+// 타임스탬프가 now 와 SignatureMaxAge 이상 차이나면 서명이 맞더라도 거절한다.
+func VerifySignature(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	signature := header.Get(HeaderSignature)
+	timestamp := header.Get(HeaderTimestamp)
+	if signature == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if diff := now.Sub(time.Unix(ts, 0)).Abs(); diff > SignatureMaxAge {
+		return ErrExpiredTimestamp
+	}
+
+	expected := ComputeSignature(signingSecret, ts, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
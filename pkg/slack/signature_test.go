@@ -0,0 +1,88 @@
+package slack_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+func TestVerifySignature(t *testing.T) {
+	// 슬랙 문서의 예시 요청.
+	const (
+		secret    = "8f742231b10e8888abcd99yyyzzz85a5"
+		timestamp = "1531420618"
+		signature = "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"
+		body      = "token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c"
+	)
+	sent := time.Unix(1531420618, 0)
+
+	testCases := []struct {
+		desc      string
+		signature string
+		timestamp string
+		body      string
+		now       time.Time
+		expected  error
+	}{
+		{
+			desc:      "valid",
+			signature: signature,
+			timestamp: timestamp,
+			body:      body,
+			now:       sent.Add(time.Minute),
+			expected:  nil,
+		},
+		{
+			desc:      "tampered body",
+			signature: signature,
+			timestamp: timestamp,
+			body:      body + "&text=admin",
+			now:       sent,
+			expected:  slack.ErrInvalidSignature,
+		},
+		{
+			desc:      "replayed",
+			signature: signature,
+			timestamp: timestamp,
+			body:      body,
+			now:       sent.Add(slack.SignatureMaxAge + time.Second),
+			expected:  slack.ErrExpiredTimestamp,
+		},
+		{
+			desc:      "invalid timestamp",
+			signature: signature,
+			timestamp: "yesterday",
+			body:      body,
+			now:       sent,
+			expected:  slack.ErrInvalidSignature,
+		},
+		{
+			desc:      "missing signature",
+			signature: "",
+			timestamp: timestamp,
+			body:      body,
+			now:       sent,
+			expected:  slack.ErrMissingSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(slack.HeaderSignature, tc.signature)
+			header.Set(slack.HeaderTimestamp, tc.timestamp)
+
+			err := slack.VerifySignature(secret, header, []byte(tc.body), tc.now)
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+
+	if got := slack.ComputeSignature(secret, sent.Unix(), []byte(body)); got != signature {
+		t.Errorf("expected %s, got %s", signature, got)
+	}
+}
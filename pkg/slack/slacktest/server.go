@@ -0,0 +1,345 @@
+// Package slacktest 는 네트워크 없이 슬랙 앱을 테스트할 수 있도록
+// 슬랙 Web API 와 소켓 모드 웹소켓을 흉내내는 서버를 제공한다.
+//
+//	server := slacktest.NewServer()
+//	defer server.Close()
+//
+//	client := slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+package slacktest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+const (
+	TeamID    = "T0SLACKTEST"
+	AppID     = "A0SLACKTEST"
+	BotID     = "B0SLACKTEST"
+	BotUserID = "U0SLACKTEST"
+)
+
+// 서버로 전달된 Web API 요청.
+type Request struct {
+	// 호출한 API 메서드. e.g. "chat.postMessage"
+	Method string
+	// URL 쿼리 파라미터.
+	Query url.Values
+	// 요청 본문.
+	Body []byte
+}
+
+// 소켓 모드 웹소켓으로 전달받은 응답.
+type Ack struct {
+	EnvelopeID string
+	// 응답에 포함된 payload. 없으면 비어있다.
+	Payload json.RawMessage
+}
+
+// response_url 로 전달받은 응답.
+type Response struct {
+	// response_url 의 마지막 경로.
+	ID string
+	// 전달받은 응답의 본문.
+	Payload slack.InteractiveResponsePayload
+}
+
+type Server struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+	handlers map[string]apiHandler
+
+	mu sync.Mutex
+	// 상태가 바뀔 때마다 닫히고 새로 만들어진다. 대기중인 Wait* 함수를 깨우는 데 사용한다.
+	changed    chan struct{}
+	seq        int
+	channels   []slack.ConversationObject
+	messages   map[string][]slack.MessageObject
+	ephemerals []slack.PostEphemeralRequest
+	scheduled  []slack.ScheduleMessageRequest
+	users      []slack.User
+	usergroups []slack.Usergroup
+	views      []slack.ViewObject
+	requests   []Request
+	acks       []Ack
+	responses  []Response
+	failures   map[string]string
+	conns      []*conn
+	peakConns  int
+}
+
+func NewServer() *Server {
+	s := &Server{
+		changed:  make(chan struct{}),
+		messages: make(map[string][]slack.MessageObject),
+		failures: make(map[string]string),
+	}
+	s.handlers = map[string]apiHandler{
+		"apps.connections.open": s.connectionsOpen,
+		"chat.postMessage":      s.postMessage,
+		"chat.update":           s.updateMessage,
+		"chat.delete":           s.deleteMessage,
+		"chat.postEphemeral":    s.postEphemeral,
+		"chat.scheduleMessage":  s.scheduleMessage,
+		"chat.getPermalink":     s.getPermalink,
+		"conversations.list":    s.listChannels,
+		"conversations.history": s.listMessages,
+		"conversations.replies": s.listReplies,
+		"users.list":            s.listUsers,
+		"users.info":            s.getUserInfo,
+		"users.lookupByEmail":   s.lookupUserByEmail,
+		"users.profile.get":     s.getUserProfile,
+		"usergroups.list":       s.listUsergroups,
+		"usergroups.users.list": s.listUsergroupUsers,
+		"views.open":            s.openView,
+		"views.push":            s.openView,
+		"views.update":          s.updateView,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/{method}", s.handleAPI)
+	mux.HandleFunc("GET /socket", s.handleSocket)
+	mux.HandleFunc("POST /response/{id}", s.handleResponse)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// 열려있는 웹소켓 연결을 모두 닫고 서버를 종료한다.
+func (s *Server) Close() {
+	s.mu.Lock()
+	conns := s.conns
+	s.conns = nil
+	s.mu.Unlock()
+
+	for _, c := range conns {
+		_ = c.Close()
+	}
+	s.server.Close()
+}
+
+// 서버의 주소. e.g. "http://127.0.0.1:12345"
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// slack.WithBaseURL 에 전달할 Web API 주소.
+func (s *Server) APIURL() string {
+	return s.server.URL + "/api"
+}
+
+// 슬랙 클라이언트가 이 서버로 요청을 보내도록 하는 옵션.
+func (s *Server) ClientOptions() []slack.Option {
+	return []slack.Option{
+		slack.WithBaseURL(s.APIURL()),
+		slack.WithHTTPClient(*s.server.Client()),
+	}
+}
+
+// 이 서버가 응답을 기록하는 response_url.
+func (s *Server) ResponseURL(id string) string {
+	return s.server.URL + "/response/" + id
+}
+
+// 채널을 추가한다. 추가하지 않은 채널에 메시지를 보내면 channel_not_found 오류를 응답한다.
+func (s *Server) AddChannel(channel slack.ConversationObject) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.channels = append(s.channels, channel)
+	s.notify()
+}
+
+// 사용자를 추가한다. 같은 ID 의 사용자가 있으면 바꾼다.
+func (s *Server) AddUser(user slack.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.findUser(user.ID); i >= 0 {
+		s.users[i] = user
+	} else {
+		s.users = append(s.users, user)
+	}
+	s.notify()
+}
+
+// 사용자 그룹을 추가한다. group.Users 에 그룹에 속한 사용자의 ID 를 지정한다.
+func (s *Server) AddUsergroup(group slack.Usergroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.usergroups = append(s.usergroups, group)
+	s.notify()
+}
+
+// Web API 메서드가 code 오류를 응답하도록 한다. code 가 비어있으면 정상적으로 응답한다.
+func (s *Server) SetError(method string, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if code == "" {
+		delete(s.failures, method)
+	} else {
+		s.failures[method] = code
+	}
+}
+
+// 채널에 게시된 메시지를 게시된 순서대로 반환한다.
+func (s *Server) Messages(channel string) []slack.MessageObject {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]slack.MessageObject(nil), s.messages[channel]...)
+}
+
+func (s *Server) Ephemerals() []slack.PostEphemeralRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]slack.PostEphemeralRequest(nil), s.ephemerals...)
+}
+
+func (s *Server) ScheduledMessages() []slack.ScheduleMessageRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]slack.ScheduleMessageRequest(nil), s.scheduled...)
+}
+
+func (s *Server) Views() []slack.ViewObject {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]slack.ViewObject(nil), s.views...)
+}
+
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) Acks() []Ack {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Ack(nil), s.acks...)
+}
+
+func (s *Server) Responses() []Response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Response(nil), s.responses...)
+}
+
+// 채널에 n 개 이상의 메시지가 게시될 때까지 기다린다.
+func (s *Server) WaitForMessages(ctx context.Context, channel string, n int) ([]slack.MessageObject, error) {
+	var messages []slack.MessageObject
+	err := s.wait(ctx, func() bool {
+		messages = s.messages[channel]
+		return len(messages) >= n
+	})
+	return append([]slack.MessageObject(nil), messages...), err
+}
+
+// Web API 메서드가 n 번 이상 호출될 때까지 기다린다.
+func (s *Server) WaitForRequests(ctx context.Context, method string, n int) error {
+	return s.wait(ctx, func() bool {
+		count := 0
+		for _, req := range s.requests {
+			if req.Method == method {
+				count++
+			}
+		}
+		return count >= n
+	})
+}
+
+// 봇이 envelopeID 에 대한 응답을 보낼 때까지 기다린다.
+func (s *Server) WaitForAck(ctx context.Context, envelopeID string) (*Ack, error) {
+	var ack *Ack
+	err := s.wait(ctx, func() bool {
+		for i := range s.acks {
+			if s.acks[i].EnvelopeID == envelopeID {
+				ack = &s.acks[i]
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	a := *ack
+	return &a, nil
+}
+
+// response_url 로 응답이 전달될 때까지 기다린다.
+func (s *Server) WaitForResponse(ctx context.Context, id string) (*Response, error) {
+	var response *Response
+	err := s.wait(ctx, func() bool {
+		for i := range s.responses {
+			if s.responses[i].ID == id {
+				response = &s.responses[i]
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := *response
+	return &r, nil
+}
+
+// cond 가 참이 될 때까지 기다린다. cond 는 잠금을 얻은 상태로 호출된다.
+func (s *Server) wait(ctx context.Context, cond func() bool) error {
+	for {
+		s.mu.Lock()
+		ok := cond()
+		changed := s.changed
+		s.mu.Unlock()
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// 상태가 바뀌었음을 대기중인 함수에 알린다. 잠금을 얻은 상태로 호출해야 한다.
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// 메시지 타임스탬프와 ID 를 만들기 위한 순번. 잠금을 얻은 상태로 호출해야 한다.
+func (s *Server) next() int {
+	s.seq++
+	return s.seq
+}
+
+// 슬랙처럼 소수점 아래 6자리의 문자열을 만든다. 끝자리를 항상 0 으로 두어서
+// 숫자로 바꿨다가 되돌리면 다른 값이 되는 것을 테스트에서 잡을 수 있게 한다. e.g. "1700000000.000010"
+func (s *Server) nextTimestamp() string {
+	return fmt.Sprintf("1700000000.%06d", s.next()*10)
+}
+
+func (s *Server) nextID(prefix string) string {
+	return fmt.Sprintf("%s%08d", prefix, s.next())
+}
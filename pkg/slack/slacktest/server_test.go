@@ -0,0 +1,180 @@
+package slacktest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/slacktest"
+)
+
+func newClient(server *slacktest.Server) *slack.Client {
+	return slack.NewClient("xapp-test", "xoxb-test", server.ClientOptions()...)
+}
+
+func TestServerMessages(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "C1", IsMember: true})
+
+	ctx := context.Background()
+	client := newClient(server)
+
+	posted, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "안녕하세요"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.PostMessage(ctx, &slack.PostMessageRequest{
+		Channel:         "C1",
+		Text:            "답글",
+		ThreadTimestamp: posted.Timestamp,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.UpdateMessage(ctx, &slack.UpdateMessageRequest{
+		Channel:   "C1",
+		Timestamp: posted.Timestamp,
+		Text:      "수정됨",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	messages, err := client.ListAllMessages(ctx, &slack.ListMessagesRequest{Channel: "C1"}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 || messages[0].Text != "수정됨" || messages[0].ReplyCount != 1 {
+		t.Errorf("unexpected messages: %+v", messages)
+	}
+
+	replies, err := client.ListAllReplies(ctx, &slack.ListRepliesRequest{Channel: "C1", Timestamp: posted.Timestamp}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(replies) != 2 || replies[1].Text != "답글" {
+		t.Errorf("unexpected replies: %+v", replies)
+	}
+
+	if _, err := client.DeleteMessage(ctx, &slack.DeleteMessageRequest{Channel: "C1", Timestamp: posted.Timestamp}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.Messages("C1"); len(got) != 1 {
+		t.Errorf("expected 1 message after delete, got %d", len(got))
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddChannel(slack.ConversationObject{ID: "C1", IsMember: false})
+
+	client := newClient(server)
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc    string
+		call    func() error
+		wantErr error
+	}{
+		{
+			desc: "channel not found",
+			call: func() error {
+				_, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C404", Text: "a"})
+				return err
+			},
+			wantErr: slack.ErrChannelNotFound,
+		},
+		{
+			desc: "not in channel",
+			call: func() error {
+				_, err := client.PostMessage(ctx, &slack.PostMessageRequest{Channel: "C1", Text: "a"})
+				return err
+			},
+			wantErr: slack.ErrNotInChannel,
+		},
+		{
+			desc: "user not found",
+			call: func() error {
+				_, err := client.GetUserProfile(ctx, "U404")
+				return err
+			},
+			wantErr: &slack.APIError{Code: "user_not_found"},
+		},
+		{
+			desc: "not authed",
+			call: func() error {
+				_, err := slack.NewClient("", "", server.ClientOptions()...).GetUserProfile(ctx, "U1")
+				return err
+			},
+			wantErr: &slack.APIError{Code: "not_authed"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := tc.call(); !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestServerListChannels(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	for _, id := range []string{"C1", "C2", "C3", "C4", "C5"} {
+		server.AddChannel(slack.ConversationObject{ID: id, IsPrivate: true, IsMember: true})
+	}
+	server.AddChannel(slack.ConversationObject{ID: "C6", IsMember: true})
+
+	channels, err := newClient(server).ListAllChannels(context.Background(), &slack.ListChannelsRequest{
+		Limit: 2,
+		Types: slack.PrivateChannel,
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(channels) != 5 {
+		t.Errorf("expected 5 channels, got %d", len(channels))
+	}
+	if err := server.WaitForRequests(context.Background(), "conversations.list", 3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerUsergroups(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+	server.AddUsergroup(slack.Usergroup{ID: "S1", Handle: "backend", Users: []string{"U1", "U2"}})
+	server.AddUsergroup(slack.Usergroup{ID: "S2", Handle: "legacy", DateDelete: 1700000000, Users: []string{"U3"}})
+
+	client := newClient(server)
+	ctx := context.Background()
+
+	resp, err := client.ListUsergroups(ctx, &slack.ListUsergroupsRequest{IncludeCount: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Usergroups) != 1 || resp.Usergroups[0].UserCount != 2 || resp.Usergroups[0].Users != nil {
+		t.Errorf("unexpected usergroups: %+v", resp.Usergroups)
+	}
+	resp, err = client.ListUsergroups(ctx, &slack.ListUsergroupsRequest{IncludeDisabled: true, IncludeUsers: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Usergroups) != 2 || len(resp.Usergroups[1].Users) != 1 {
+		t.Errorf("unexpected usergroups: %+v", resp.Usergroups)
+	}
+
+	users, err := client.ListUsergroupUsers(ctx, &slack.ListUsergroupUsersRequest{Usergroup: "S1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users.Users) != 2 {
+		t.Errorf("unexpected users: %+v", users.Users)
+	}
+	if _, err := client.ListUsergroupUsers(ctx, &slack.ListUsergroupUsersRequest{Usergroup: "S404"}); !errors.Is(err, &slack.APIError{Code: "no_such_subteam"}) {
+		t.Errorf("expected no_such_subteam, got %v", err)
+	}
+}
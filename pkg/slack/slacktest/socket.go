@@ -0,0 +1,211 @@
+package slacktest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+)
+
+// 웹소켓에 연결된 봇이 없을 때 이벤트를 보내면 반환되는 오류.
+var ErrNoConnection = errors.New("slacktest: no socket mode connection")
+
+// 여러 고루틴에서 이벤트를 보낼 수 있도록 쓰기를 직렬화한 웹소켓 연결.
+type conn struct {
+	*websocket.Conn
+	mu sync.Mutex
+}
+
+func (c *conn) writeJSON(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.WriteJSON(v)
+}
+
+func (s *Server) handleSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		slog.Error("failed to upgrade websocket", slog.Any("error", err))
+		return
+	}
+	c := &conn{Conn: ws}
+
+	s.mu.Lock()
+	s.conns = append(s.conns, c)
+	count := len(s.conns)
+	s.peakConns = max(s.peakConns, count)
+	s.notify()
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.conns = slices.DeleteFunc(s.conns, func(other *conn) bool { return other == c })
+		s.notify()
+		s.mu.Unlock()
+		_ = c.Close()
+	}()
+
+	hello := &slack.HelloEvent{
+		Type:      slack.EventTypeHello,
+		ConnInfo:  slack.ConnectionInfo{AppID: AppID},
+		ConnCount: count,
+		DebugInfo: slack.DebugInfo{Host: "slacktest"},
+	}
+	if err := c.writeJSON(hello); err != nil {
+		return
+	}
+
+	for {
+		_, data, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		ack := struct {
+			EnvelopeID string          `json:"envelope_id"`
+			Payload    json.RawMessage `json:"payload"`
+		}{}
+		if err := json.Unmarshal(data, &ack); err != nil || ack.EnvelopeID == "" {
+			slog.Warn("unexpected socket message", slog.String("data", string(data)))
+			continue
+		}
+
+		s.mu.Lock()
+		s.acks = append(s.acks, Ack{EnvelopeID: ack.EnvelopeID, Payload: ack.Payload})
+		s.notify()
+		s.mu.Unlock()
+	}
+}
+
+// 연결된 봇의 수.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.conns)
+}
+
+// 동시에 연결되어 있던 봇의 최대 수.
+func (s *Server) PeakConnections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.peakConns
+}
+
+// 봇이 웹소켓에 n 개 이상 연결될 때까지 기다린다.
+func (s *Server) WaitForConnections(ctx context.Context, n int) error {
+	return s.wait(ctx, func() bool { return len(s.conns) >= n })
+}
+
+// 가장 최근에 연결된 웹소켓으로 이벤트를 보낸다.
+func (s *Server) Send(event any) error {
+	s.mu.Lock()
+	var c *conn
+	if len(s.conns) > 0 {
+		c = s.conns[len(s.conns)-1]
+	}
+	s.mu.Unlock()
+
+	if c == nil {
+		return ErrNoConnection
+	}
+	return c.writeJSON(event)
+}
+
+// 연결을 끊도록 요청하는 disconnect 이벤트를 보낸다. e.g. "refresh_requested", "link_disabled"
+func (s *Server) SendDisconnect(reason string) error {
+	return s.Send(&slack.DisconnectEvent{
+		Type:      slack.EventTypeDisconnect,
+		Reason:    reason,
+		DebugInfo: slack.DebugInfo{Host: "slacktest"},
+	})
+}
+
+// 슬래시 커맨드 이벤트를 보내고 envelope_id 를 반환한다.
+// response_url 과 trigger_id 가 비어있으면 이 서버의 주소로 채운다.
+func (s *Server) SendSlashCommand(payload slack.SlashCommandEventPayload) (string, error) {
+	s.mu.Lock()
+	envelopeID := s.nextID("envelope-")
+	s.mu.Unlock()
+
+	if payload.AppID == "" {
+		payload.AppID = AppID
+	}
+	if payload.TeamID == "" {
+		payload.TeamID = TeamID
+	}
+	if payload.ResponseURL == "" {
+		payload.ResponseURL = s.ResponseURL(envelopeID)
+	}
+	if payload.TriggerID == "" {
+		payload.TriggerID = "trigger-" + envelopeID
+	}
+
+	event := &slack.SlashCommandEvent{
+		Type:                   slack.EventTypeSlashCommand,
+		EnvelopeID:             envelopeID,
+		AcceptsResponsePayload: true,
+		Payload:                payload,
+	}
+	return envelopeID, s.Send(event)
+}
+
+// 상호작용 이벤트를 보내고 envelope_id 를 반환한다.
+// response_url 과 trigger_id 가 비어있으면 이 서버의 주소로 채운다.
+func (s *Server) SendInteractive(payload slack.InteractiveEventPayload) (string, error) {
+	s.mu.Lock()
+	envelopeID := s.nextID("envelope-")
+	s.mu.Unlock()
+
+	// 모달 이벤트와 block_suggestion, 전역 바로가기에는 response_url 이 없다.
+	hasResponseURL := payload.Type != slack.InteractionTypeViewSubmission &&
+		payload.Type != slack.InteractionTypeViewClosed &&
+		payload.Type != slack.InteractionTypeBlockSuggestion &&
+		payload.Type != slack.InteractionTypeShortcut
+	if payload.ResponseURL == "" && hasResponseURL {
+		payload.ResponseURL = s.ResponseURL(envelopeID)
+	}
+	if payload.TriggerID == "" {
+		payload.TriggerID = "trigger-" + envelopeID
+	}
+
+	event := &slack.InteractiveEvent{
+		Type:                   slack.EventTypeInteractive,
+		EnvelopeID:             envelopeID,
+		AcceptsResponsePayload: payload.Type == slack.InteractionTypeViewSubmission || payload.Type == slack.InteractionTypeBlockSuggestion,
+		Payload:                payload,
+	}
+	return envelopeID, s.Send(event)
+}
+
+// Events API 이벤트를 보내고 envelope_id 를 반환한다.
+func (s *Server) SendEventsAPI(event slack.InnerEvent) (string, error) {
+	s.mu.Lock()
+	envelopeID := s.nextID("envelope-")
+	eventID := s.nextID("Ev")
+	s.mu.Unlock()
+
+	envelope := &slack.EventsAPIEvent{
+		Type:       slack.EventTypeEventsAPI,
+		EnvelopeID: envelopeID,
+		Payload: slack.EventsAPIPayload{
+			TeamID:    TeamID,
+			AppID:     AppID,
+			Type:      "event_callback",
+			EventID:   eventID,
+			EventTime: int(time.Now().Unix()),
+			Event:     event,
+		},
+	}
+	return envelopeID, s.Send(envelope)
+}
@@ -0,0 +1,503 @@
+package slacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+// Web API 메서드를 처리한다. 성공하면 응답을, 실패하면 슬랙의 오류 코드를 반환한다.
+// 잠금을 얻은 상태로 호출된다.
+type apiHandler func(req *Request) (any, string)
+
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := Request{
+		Method: r.PathValue("method"),
+		Query:  r.URL.Query(),
+		Body:   body,
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	var (
+		resp any
+		code string
+	)
+	handler, ok := s.handlers[req.Method]
+	failure, failing := s.failures[req.Method]
+	switch {
+	case !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "):
+		code = "not_authed"
+	case failing:
+		code = failure
+	case !ok:
+		code = "unknown_method"
+	default:
+		resp, code = handler(&req)
+	}
+	s.notify()
+	s.mu.Unlock()
+
+	if code != "" {
+		resp = &slack.APIResponse{OK: false, Error: code}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.Error("failed to write response", slog.Any("error", err))
+	}
+}
+
+func (s *Server) handleResponse(w http.ResponseWriter, r *http.Request) {
+	response := Response{ID: r.PathValue("id")}
+	if err := json.NewDecoder(r.Body).Decode(&response.Payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.responses = append(s.responses, response)
+	s.notify()
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"ok":true}`))
+}
+
+func (s *Server) connectionsOpen(req *Request) (any, string) {
+	return &slack.GetWebSocketURLResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		URL:         "ws" + strings.TrimPrefix(s.server.URL, "http") + "/socket",
+	}, ""
+}
+
+// 메시지를 게시할 수 있는 채널인지 확인한다.
+func (s *Server) checkChannel(id string) string {
+	i := slices.IndexFunc(s.channels, func(c slack.ConversationObject) bool { return c.ID == id })
+	switch {
+	case i < 0:
+		return "channel_not_found"
+	case !s.channels[i].IsMember:
+		return "not_in_channel"
+	case s.channels[i].IsArchived:
+		return "is_archived"
+	}
+	return ""
+}
+
+func checkMessage(text string, blocks blockkit.Blocks) string {
+	if text == "" && len(blocks) == 0 {
+		return "no_text"
+	}
+	if err := blocks.Validate(); err != nil {
+		return "invalid_blocks"
+	}
+	return ""
+}
+
+func (s *Server) findMessage(channel string, ts string) int {
+	return slices.IndexFunc(s.messages[channel], func(m slack.MessageObject) bool { return m.Timestamp == ts })
+}
+
+func (s *Server) postMessage(req *Request) (any, string) {
+	r := &slack.PostMessageRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if code := s.checkChannel(r.Channel); code != "" {
+		return nil, code
+	}
+	if code := checkMessage(r.Text, r.Blocks); code != "" {
+		return nil, code
+	}
+
+	message := slack.MessageObject{
+		Type:            "message",
+		AppID:           AppID,
+		BotID:           BotID,
+		User:            BotUserID,
+		Text:            r.Text,
+		Timestamp:       s.nextTimestamp(),
+		ThreadTimestamp: r.ThreadTimestamp,
+	}
+	if r.ThreadTimestamp != "" {
+		i := s.findMessage(r.Channel, r.ThreadTimestamp)
+		if i < 0 {
+			return nil, "thread_not_found"
+		}
+		parent := &s.messages[r.Channel][i]
+		parent.ThreadTimestamp = parent.Timestamp
+		parent.ReplyCount++
+	}
+	s.messages[r.Channel] = append(s.messages[r.Channel], message)
+
+	return &slack.PostMessageResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Channel:     r.Channel,
+		Timestamp:   message.Timestamp,
+	}, ""
+}
+
+func (s *Server) updateMessage(req *Request) (any, string) {
+	r := &slack.UpdateMessageRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if code := s.checkChannel(r.Channel); code != "" {
+		return nil, code
+	}
+	if code := checkMessage(r.Text, r.Blocks); code != "" {
+		return nil, code
+	}
+	i := s.findMessage(r.Channel, r.Timestamp)
+	if i < 0 {
+		return nil, "message_not_found"
+	}
+	s.messages[r.Channel][i].Text = r.Text
+
+	return &slack.UpdateMessageResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Channel:     r.Channel,
+		Timestamp:   r.Timestamp,
+		Text:        r.Text,
+	}, ""
+}
+
+func (s *Server) deleteMessage(req *Request) (any, string) {
+	r := &slack.DeleteMessageRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if code := s.checkChannel(r.Channel); code != "" {
+		return nil, code
+	}
+	i := s.findMessage(r.Channel, r.Timestamp)
+	if i < 0 {
+		return nil, "message_not_found"
+	}
+	s.messages[r.Channel] = slices.Delete(s.messages[r.Channel], i, i+1)
+
+	return &slack.DeleteMessageResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Channel:     r.Channel,
+		Timestamp:   r.Timestamp,
+	}, ""
+}
+
+func (s *Server) postEphemeral(req *Request) (any, string) {
+	r := &slack.PostEphemeralRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if code := s.checkChannel(r.Channel); code != "" {
+		return nil, code
+	}
+	if r.User == "" {
+		return nil, "user_not_in_channel"
+	}
+	if code := checkMessage(r.Text, r.Blocks); code != "" {
+		return nil, code
+	}
+	s.ephemerals = append(s.ephemerals, *r)
+
+	return &slack.PostEphemeralResponse{
+		APIResponse:      slack.APIResponse{OK: true},
+		MessageTimestamp: s.nextTimestamp(),
+	}, ""
+}
+
+func (s *Server) scheduleMessage(req *Request) (any, string) {
+	r := &slack.ScheduleMessageRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if code := s.checkChannel(r.Channel); code != "" {
+		return nil, code
+	}
+	if code := checkMessage(r.Text, r.Blocks); code != "" {
+		return nil, code
+	}
+	if r.PostAt <= time.Now().Unix() {
+		return nil, "time_in_past"
+	}
+	s.scheduled = append(s.scheduled, *r)
+
+	return &slack.ScheduleMessageResponse{
+		APIResponse:        slack.APIResponse{OK: true},
+		Channel:            r.Channel,
+		ScheduledMessageID: s.nextID("Q"),
+		PostAt:             r.PostAt,
+	}, ""
+}
+
+func (s *Server) getPermalink(req *Request) (any, string) {
+	channel := req.Query.Get("channel")
+	ts := req.Query.Get("message_ts")
+	if s.findMessage(channel, ts) < 0 {
+		return nil, "message_not_found"
+	}
+	digits := strings.ReplaceAll(ts, ".", "")
+
+	return &slack.GetPermalinkResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Channel:     channel,
+		Permalink:   fmt.Sprintf("https://slacktest.slack.com/archives/%s/p%s", channel, digits),
+	}, ""
+}
+
+// 커서는 다음 페이지가 시작하는 위치를 나타낸다.
+func paginate(req *Request, total int) (int, int, string) {
+	start, _ := strconv.Atoi(req.Query.Get("cursor"))
+	limit, err := strconv.Atoi(req.Query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	start = min(max(start, 0), total)
+	end := min(start+limit, total)
+	cursor := ""
+	if end < total {
+		cursor = strconv.Itoa(end)
+	}
+	return start, end, cursor
+}
+
+func (s *Server) listChannels(req *Request) (any, string) {
+	types := req.Query.Get("types")
+	if types == "" {
+		types = string(slack.PublicChannel)
+	}
+	channels := make([]slack.ConversationObject, 0, len(s.channels))
+	for _, channel := range s.channels {
+		var channelType slack.ChannelType
+		switch {
+		case channel.IsDM:
+			channelType = slack.DirectMessage
+		case channel.IsGroupDM:
+			channelType = slack.GroupDirectMessage
+		case channel.IsPrivate:
+			channelType = slack.PrivateChannel
+		default:
+			channelType = slack.PublicChannel
+		}
+		if !slices.Contains(strings.Split(types, ","), string(channelType)) {
+			continue
+		}
+		if req.Query.Get("exclude_archived") == "true" && channel.IsArchived {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+
+	start, end, cursor := paginate(req, len(channels))
+	return &slack.ListChannelsResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Channels:    channels[start:end],
+		Metadata:    slack.ResponseMetadata{NextCursor: cursor},
+	}, ""
+}
+
+func (s *Server) listMessages(req *Request) (any, string) {
+	channel := req.Query.Get("channel")
+	if !slices.ContainsFunc(s.channels, func(c slack.ConversationObject) bool { return c.ID == channel }) {
+		return nil, "channel_not_found"
+	}
+
+	// 스레드의 답글을 제외하고 최신 메시지부터 반환한다.
+	messages := make([]slack.MessageObject, 0, len(s.messages[channel]))
+	for _, message := range slices.Backward(s.messages[channel]) {
+		if message.ThreadTimestamp == "" || message.ThreadTimestamp == message.Timestamp {
+			messages = append(messages, message)
+		}
+	}
+
+	start, end, cursor := paginate(req, len(messages))
+	return &slack.ListMessagesResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		HasMore:     cursor != "",
+		Messages:    messages[start:end],
+		Metadata:    slack.ResponseMetadata{NextCursor: cursor},
+	}, ""
+}
+
+func (s *Server) listReplies(req *Request) (any, string) {
+	channel := req.Query.Get("channel")
+	if !slices.ContainsFunc(s.channels, func(c slack.ConversationObject) bool { return c.ID == channel }) {
+		return nil, "channel_not_found"
+	}
+	ts := req.Query.Get("ts")
+	if s.findMessage(channel, ts) < 0 {
+		return nil, "thread_not_found"
+	}
+
+	// 스레드의 첫 메시지와 답글을 오래된 메시지부터 반환한다.
+	messages := make([]slack.MessageObject, 0)
+	for _, message := range s.messages[channel] {
+		if message.Timestamp == ts || message.ThreadTimestamp == ts {
+			messages = append(messages, message)
+		}
+	}
+
+	start, end, cursor := paginate(req, len(messages))
+	return &slack.ListRepliesResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		HasMore:     cursor != "",
+		Messages:    messages[start:end],
+		Metadata:    slack.ResponseMetadata{NextCursor: cursor},
+	}, ""
+}
+
+func (s *Server) findUser(id string) int {
+	return slices.IndexFunc(s.users, func(u slack.User) bool { return u.ID == id })
+}
+
+func (s *Server) listUsers(req *Request) (any, string) {
+	start, end, cursor := paginate(req, len(s.users))
+	return &slack.ListUsersResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Members:     slices.Clone(s.users[start:end]),
+		Metadata:    slack.ResponseMetadata{NextCursor: cursor},
+	}, ""
+}
+
+func (s *Server) getUserInfo(req *Request) (any, string) {
+	i := s.findUser(req.Query.Get("user"))
+	if i < 0 {
+		return nil, "user_not_found"
+	}
+	return &slack.UserResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		User:        s.users[i],
+	}, ""
+}
+
+func (s *Server) lookupUserByEmail(req *Request) (any, string) {
+	email := req.Query.Get("email")
+	i := slices.IndexFunc(s.users, func(u slack.User) bool {
+		return email != "" && strings.EqualFold(u.Profile.Email, email)
+	})
+	if i < 0 {
+		return nil, "users_not_found"
+	}
+	return &slack.UserResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		User:        s.users[i],
+	}, ""
+}
+
+func (s *Server) getUserProfile(req *Request) (any, string) {
+	i := s.findUser(req.Query.Get("user"))
+	if i < 0 {
+		return nil, "user_not_found"
+	}
+	return &slack.GetUserProfileResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Profile:     s.users[i].Profile,
+	}, ""
+}
+
+func (s *Server) listUsergroups(req *Request) (any, string) {
+	groups := make([]slack.Usergroup, 0, len(s.usergroups))
+	for _, group := range s.usergroups {
+		if group.DateDelete != 0 && req.Query.Get("include_disabled") != "true" {
+			continue
+		}
+		if req.Query.Get("include_count") == "true" {
+			group.UserCount = len(group.Users)
+		}
+		if req.Query.Get("include_users") != "true" {
+			group.Users = nil
+		}
+		groups = append(groups, group)
+	}
+	return &slack.ListUsergroupsResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Usergroups:  groups,
+	}, ""
+}
+
+func (s *Server) listUsergroupUsers(req *Request) (any, string) {
+	id := req.Query.Get("usergroup")
+	i := slices.IndexFunc(s.usergroups, func(g slack.Usergroup) bool { return g.ID == id })
+	if i < 0 {
+		return nil, "no_such_subteam"
+	}
+	return &slack.ListUsergroupUsersResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		Users:       slices.Clone(s.usergroups[i].Users),
+	}, ""
+}
+
+func (s *Server) openView(req *Request) (any, string) {
+	r := &slack.OpenViewRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	if r.TriggerID == "" {
+		return nil, "invalid_trigger_id"
+	}
+	if r.View == nil {
+		return nil, "invalid_arguments"
+	}
+	if err := r.View.Validate(); err != nil {
+		return nil, "invalid_arguments"
+	}
+
+	view := slack.ViewObject{
+		ID:              s.nextID("V"),
+		TeamID:          TeamID,
+		Type:            blockkit.ViewTypeModal,
+		CallbackID:      r.View.CallbackID,
+		PrivateMetadata: r.View.PrivateMetadata,
+		ExternalID:      r.View.ExternalID,
+		AppID:           AppID,
+		BotID:           BotID,
+	}
+	view.RootViewID = view.ID
+	s.views = append(s.views, view)
+
+	return &slack.ViewResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		View:        view,
+	}, ""
+}
+
+func (s *Server) updateView(req *Request) (any, string) {
+	r := &slack.UpdateViewRequest{}
+	if err := json.Unmarshal(req.Body, r); err != nil {
+		return nil, "invalid_json"
+	}
+	i := slices.IndexFunc(s.views, func(v slack.ViewObject) bool {
+		return (r.ViewID != "" && v.ID == r.ViewID) || (r.ExternalID != "" && v.ExternalID == r.ExternalID)
+	})
+	if i < 0 {
+		return nil, "not_found"
+	}
+	if r.View == nil {
+		return nil, "invalid_arguments"
+	}
+	if err := r.View.Validate(); err != nil {
+		return nil, "invalid_arguments"
+	}
+
+	view := &s.views[i]
+	view.CallbackID = r.View.CallbackID
+	view.PrivateMetadata = r.View.PrivateMetadata
+
+	return &slack.ViewResponse{
+		APIResponse: slack.APIResponse{OK: true},
+		View:        *view,
+	}, ""
+}
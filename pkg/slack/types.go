@@ -2,7 +2,6 @@ package slack
 
 import (
 	"strconv"
-	"strings"
 
 	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
 )
@@ -49,33 +48,24 @@ type ListChannelsRequest struct {
 	Types ChannelType `json:"types,omitempty"`
 }
 
-func (r *ListChannelsRequest) URLParams() string {
-	var builder strings.Builder
-	builder.WriteString("?")
+func (r *ListChannelsRequest) params() map[string]string {
+	params := map[string]string{}
 	if len(r.Cursor) > 0 {
-		builder.WriteString("cursor=")
-		builder.WriteString(r.Cursor)
-		builder.WriteString("&")
+		params["cursor"] = r.Cursor
 	}
 	if r.ExcludeArchived {
-		builder.WriteString("exclude_archived=true")
-		builder.WriteString("&")
+		params["exclude_archived"] = "true"
 	}
 	if r.Limit > 0 {
-		builder.WriteString("limit=")
-		builder.WriteString(strconv.Itoa(r.Limit))
-		builder.WriteString("&")
+		params["limit"] = strconv.Itoa(r.Limit)
 	}
 	if len(r.TeamID) > 0 {
-		builder.WriteString("team_id=")
-		builder.WriteString(r.TeamID)
-		builder.WriteString("&")
+		params["team_id"] = r.TeamID
 	}
 	if len(r.Types) > 0 {
-		builder.WriteString("types=")
-		builder.WriteString(string(r.Types))
+		params["types"] = string(r.Types)
 	}
-	return builder.String()
+	return params
 }
 
 type ListChannelsResponse struct {
@@ -99,7 +89,7 @@ type PostMessageRequest struct {
 	// How this field works and whether it is required depends on other fields you use in your API call.
 	Text string `json:"text,omitempty"`
 	// A JSON-based array of structured blocks, presented as a URL-encoded string.
-	Blocks []blockkit.SlackBlock `json:"blocks,omitempty"`
+	Blocks blockkit.Blocks `json:"blocks,omitempty"`
 	// URL to an image to use as the icon for this message.
 	IconURL string `json:"icon_url,omitempty"`
 	// Emoji to use as the icon for this message. Overrides icon_url.
@@ -122,7 +112,7 @@ type PostMessageRequest struct {
 	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
 	// Provide another message's ts value to make this message a reply.
 	// Avoid using a reply's ts value. use its parent instead.
-	ThreadTimestamp float64 `json:"thread_ts,omitempty,string"`
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
 	// Pass true to enable unfurling of primarily text-based content.
 	UnfurlLinks bool `json:"unfurl_links,omitempty"`
 	// Pass false to disable unfurling of media content.
@@ -134,8 +124,128 @@ type PostMessageRequest struct {
 type PostMessageResponse struct {
 	APIResponse
 
-	Channel   string  `json:"channel"`
-	Timestamp float64 `json:"ts,string"`
+	Channel   string `json:"channel"`
+	Timestamp string `json:"ts"`
+}
+
+type UpdateMessageRequest struct {
+	// Channel containing the message to be updated.
+	Channel string `json:"channel"`
+	// Timestamp of the message to be updated.
+	Timestamp string `json:"ts"`
+	// How this field works and whether it is required depends on other fields you use in your API call.
+	Text string `json:"text,omitempty"`
+	// A JSON-based array of structured blocks, presented as a URL-encoded string.
+	Blocks blockkit.Blocks `json:"blocks,omitempty"`
+	// Find and link channel names and usernames.
+	LinkNames bool `json:"link_names,omitempty"`
+	// Change how messages are treated.
+	Parse MessageParseType `json:"parse,omitempty"`
+	// Broadcast an existing thread reply to make it visible to everyone in the channel or conversation.
+	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
+}
+
+type UpdateMessageResponse struct {
+	APIResponse
+
+	Channel   string `json:"channel"`
+	Timestamp string `json:"ts"`
+	Text      string `json:"text"`
+}
+
+type DeleteMessageRequest struct {
+	// Channel containing the message to be deleted.
+	Channel string `json:"channel"`
+	// Timestamp of the message to be deleted.
+	Timestamp string `json:"ts"`
+}
+
+type DeleteMessageResponse struct {
+	APIResponse
+
+	Channel   string `json:"channel"`
+	Timestamp string `json:"ts"`
+}
+
+type PostEphemeralRequest struct {
+	// Channel, private group, or IM channel to send message to.
+	Channel string `json:"channel"`
+	// id of the user who will receive the ephemeral message.
+	// The user should be in the channel specified by the channel argument.
+	User string `json:"user"`
+	// How this field works and whether it is required depends on other fields you use in your API call.
+	Text string `json:"text,omitempty"`
+	// A JSON-based array of structured blocks, presented as a URL-encoded string.
+	Blocks blockkit.Blocks `json:"blocks,omitempty"`
+	// URL to an image to use as the icon for this message.
+	IconURL string `json:"icon_url,omitempty"`
+	// Emoji to use as the icon for this message. Overrides icon_url.
+	IconEmoji string `json:"icon_emoji,omitempty"`
+	// Find and link channel names and usernames.
+	LinkNames bool `json:"link_names,omitempty"`
+	// Change how messages are treated.
+	Parse MessageParseType `json:"parse,omitempty"`
+	// Provide another message's ts value to post this message in a thread.
+	// Avoid using a reply's ts value. use its parent's value instead.
+	// Ephemeral messages in threads are only shown if there is already an active thread.
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
+	// Set your bot's user name.
+	Username string `json:"username,omitempty"`
+}
+
+type PostEphemeralResponse struct {
+	APIResponse
+
+	MessageTimestamp string `json:"message_ts"`
+}
+
+type ScheduleMessageRequest struct {
+	// Channel, private group, or DM channel to send message to.
+	Channel string `json:"channel"`
+	// Unix timestamp representing the future time the message should post to Slack.
+	PostAt int64 `json:"post_at"`
+	// How this field works and whether it is required depends on other fields you use in your API call.
+	Text string `json:"text,omitempty"`
+	// A JSON-based array of structured blocks, presented as a URL-encoded string.
+	Blocks blockkit.Blocks `json:"blocks,omitempty"`
+	// Find and link user groups.
+	LinkNames bool `json:"link_names,omitempty"`
+	// Disable Slack markup parsing by setting to false. Enabled by default.
+	Markdown bool `json:"mrkdwn,omitempty"`
+	// Change how messages are treated.
+	Parse MessageParseType `json:"parse,omitempty"`
+	// Used in conjunction with thread_ts and indicates whether reply should be made visible to everyone
+	// in the channel or conversation. Defaults to false.
+	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
+	// Provide another message's ts value to make this message a reply.
+	// Avoid using a reply's ts value. use its parent instead.
+	ThreadTimestamp string `json:"thread_ts,omitempty"`
+	// Pass true to enable unfurling of primarily text-based content.
+	UnfurlLinks bool `json:"unfurl_links,omitempty"`
+	// Pass false to disable unfurling of media content.
+	UnfurlMedia bool `json:"unfurl_media,omitempty"`
+}
+
+type ScheduleMessageResponse struct {
+	APIResponse
+
+	Channel            string `json:"channel"`
+	ScheduledMessageID string `json:"scheduled_message_id"`
+	PostAt             int64  `json:"post_at"`
+}
+
+type GetPermalinkRequest struct {
+	// The ID of the conversation or channel containing the message.
+	Channel string `json:"channel"`
+	// A message's ts value, uniquely identifying it within a channel.
+	MessageTimestamp string `json:"message_ts"`
+}
+
+type GetPermalinkResponse struct {
+	APIResponse
+
+	Channel   string `json:"channel"`
+	Permalink string `json:"permalink"`
 }
 
 type ListMessagesRequest struct {
@@ -160,34 +270,88 @@ type ListMessagesRequest struct {
 	Oldest float64 `json:"oldest,string,omitempty"`
 }
 
+func (r *ListMessagesRequest) params() map[string]string {
+	params := map[string]string{
+		"channel": r.Channel,
+	}
+	if len(r.Cursor) > 0 {
+		params["cursor"] = r.Cursor
+	}
+	if r.IncludeAllMetadata {
+		params["include_all_metadata"] = "true"
+	}
+	if r.Inclusive {
+		params["inclusive"] = "true"
+	}
+	if r.Limit > 0 {
+		params["limit"] = strconv.Itoa(r.Limit)
+	}
+	if r.Latest > 0 {
+		params["latest"] = strconv.FormatFloat(r.Latest, 'f', -1, 64)
+	}
+	if r.Oldest > 0 {
+		params["oldest"] = strconv.FormatFloat(r.Oldest, 'f', -1, 64)
+	}
+	return params
+}
+
 type ListMessagesResponse struct {
 	APIResponse
 
-	HasMore  bool `json:"has_more"`
-	Messages []struct {
-		AppID     string  `json:"app_id"`
-		User      string  `json:"user"`
-		Text      string  `json:"text"`
-		Timestamp float64 `json:"ts,string"`
-	} `json:"messages"`
+	HasMore  bool             `json:"has_more"`
+	Messages []MessageObject  `json:"messages"`
 	Metadata ResponseMetadata `json:"response_metadata"`
 }
 
 type ListRepliesRequest struct {
-	Channel   string  `json:"channel"`
-	Timestamp float64 `json:"ts,string"`
+	// Conversation ID to fetch thread from.
+	Channel string `json:"channel"`
+	// Unique identifier of either a thread's parent message or a message in the thread.
+	Timestamp string `json:"ts"`
+	// Paginate through collections of data by setting the `cursor` parameter to a
+	// `next_cursor` attribute returned by a previous request's `response_metadata`.
+	Cursor string `json:"cursor,omitempty"`
+	// Include messages with latest or oldest timestamp in results.
+	// Ignored unless either timestamp is specified.
+	Inclusive bool `json:"inclusive"`
+	// The maximum number of items to return.
+	// Fewer than the requested number of items may be returned,
+	// even if the end of the users list hasn't been reached.
+	Limit int `json:"limit,omitempty"`
+	// Only messages before this Unix timestamp will be included in results.
+	Latest float64 `json:"latest,string,omitempty"`
+	// Only messages after this Unix timestamp will be included in results.
+	Oldest float64 `json:"oldest,string,omitempty"`
+}
+
+func (r *ListRepliesRequest) params() map[string]string {
+	params := map[string]string{
+		"channel": r.Channel,
+		"ts":      r.Timestamp,
+	}
+	if len(r.Cursor) > 0 {
+		params["cursor"] = r.Cursor
+	}
+	if r.Inclusive {
+		params["inclusive"] = "true"
+	}
+	if r.Limit > 0 {
+		params["limit"] = strconv.Itoa(r.Limit)
+	}
+	if r.Latest > 0 {
+		params["latest"] = strconv.FormatFloat(r.Latest, 'f', -1, 64)
+	}
+	if r.Oldest > 0 {
+		params["oldest"] = strconv.FormatFloat(r.Oldest, 'f', -1, 64)
+	}
+	return params
 }
 
 type ListRepliesResponse struct {
 	APIResponse
 
-	HasMore  bool `json:"has_more"`
-	Messages []struct {
-		AppID     string  `json:"app_id"`
-		User      string  `json:"user"`
-		Text      string  `json:"text"`
-		Timestamp float64 `json:"ts,string"`
-	} `json:"messages"`
+	HasMore  bool             `json:"has_more"`
+	Messages []MessageObject  `json:"messages"`
 	Metadata ResponseMetadata `json:"response_metadata"`
 }
 
@@ -206,3 +370,201 @@ type UserProfile struct {
 	Email     string `json:"email,omitempty"`
 	Phone     string `json:"phone,omitempty"`
 }
+
+type GetUserProfileResponse struct {
+	APIResponse
+
+	Profile UserProfile `json:"profile"`
+}
+
+type User struct {
+	// Identifier for this workspace user. It is unique to the workspace containing the user.
+	ID     string `json:"id"`
+	TeamID string `json:"team_id,omitempty"`
+	// Don't use this. It once indicated the preferred username for a user,
+	// but that behavior has fundamentally changed since.
+	Name string `json:"name,omitempty"`
+	// This user has been deactivated when the value of this field is true.
+	Deleted bool `json:"deleted,omitempty"`
+	// The user's first and last name.
+	RealName string `json:"real_name,omitempty"`
+	// A human-readable string for the geographic timezone-related region this user has specified
+	// in their account. e.g. "Asia/Seoul"
+	TZ string `json:"tz,omitempty"`
+	// Describes the commonly used name of the tz timezone. e.g. "Korea Standard Time"
+	TZLabel string `json:"tz_label,omitempty"`
+	// Indicates the number of seconds to offset UTC time by for this user's tz.
+	TZOffset int `json:"tz_offset,omitempty"`
+	// Indicates whether the user is an Admin of the current workspace.
+	IsAdmin bool `json:"is_admin,omitempty"`
+	// Indicates whether the user is an Owner of the current workspace.
+	IsOwner bool `json:"is_owner,omitempty"`
+	// Indicates whether the user is actually a bot user.
+	IsBot bool `json:"is_bot,omitempty"`
+	// Indicates whether the user is an authorized user of the calling app.
+	IsAppUser bool `json:"is_app_user,omitempty"`
+	// The profile object contains the default fields of a user's workspace profile.
+	Profile UserProfile `json:"profile"`
+	// A Unix timestamp indicating when the user object was last updated.
+	Updated int64 `json:"updated,omitempty"`
+}
+
+type ListUsersRequest struct {
+	// Paginate through collections of data by setting the `cursor` parameter to a
+	// `next_cursor` attribute returned by a previous request's `response_metadata`.
+	Cursor string `json:"cursor,omitempty"`
+	// Set this to true to receive the locale for users.
+	IncludeLocale bool `json:"include_locale,omitempty"`
+	// The maximum number of items to return.
+	// Fewer than the requested number of items may be returned,
+	// even if the end of the users list hasn't been reached. Providing no limit value will result in
+	// Slack attempting to deliver you the entire result set. If the collection is too large you may
+	// experience limit_required or HTTP 500 errors.
+	Limit int `json:"limit,omitempty"`
+	// encoded team id to list users in, required if org token is used.
+	TeamID string `json:"team_id,omitempty"`
+}
+
+func (r *ListUsersRequest) params() map[string]string {
+	params := map[string]string{}
+	if len(r.Cursor) > 0 {
+		params["cursor"] = r.Cursor
+	}
+	if r.IncludeLocale {
+		params["include_locale"] = "true"
+	}
+	if r.Limit > 0 {
+		params["limit"] = strconv.Itoa(r.Limit)
+	}
+	if len(r.TeamID) > 0 {
+		params["team_id"] = r.TeamID
+	}
+	return params
+}
+
+type ListUsersResponse struct {
+	APIResponse
+
+	Members  []User           `json:"members"`
+	Metadata ResponseMetadata `json:"response_metadata"`
+}
+
+// users.info 와 users.lookupByEmail 의 응답.
+type UserResponse struct {
+	APIResponse
+
+	User User `json:"user"`
+}
+
+type Usergroup struct {
+	// The ID of the User Group.
+	ID     string `json:"id"`
+	TeamID string `json:"team_id,omitempty"`
+	// Indicates whether the User Group is shared with external organizations.
+	IsExternal bool `json:"is_external,omitempty"`
+	// A name for the User Group.
+	Name string `json:"name"`
+	// The mention handle of the User Group. e.g. "backend-team"
+	Handle string `json:"handle"`
+	// A short description of the User Group.
+	Description string `json:"description,omitempty"`
+	// A Unix timestamp indicating when the User Group was disabled. 0 if the User Group is enabled.
+	DateDelete int64 `json:"date_delete,omitempty"`
+	// A list of user IDs that belong to the User Group. Only present when include_users is true.
+	Users []string `json:"users,omitempty"`
+	// The number of users in the User Group. Only present when include_count is true.
+	UserCount int `json:"user_count,omitempty"`
+}
+
+type ListUsergroupsRequest struct {
+	// Include the number of users in each User Group.
+	IncludeCount bool `json:"include_count,omitempty"`
+	// Include results for disabled User Groups.
+	IncludeDisabled bool `json:"include_disabled,omitempty"`
+	// Include the list of users for each User Group.
+	IncludeUsers bool `json:"include_users,omitempty"`
+	// encoded team id to list user groups in, required if org token is used.
+	TeamID string `json:"team_id,omitempty"`
+}
+
+func (r *ListUsergroupsRequest) params() map[string]string {
+	params := map[string]string{}
+	if r.IncludeCount {
+		params["include_count"] = "true"
+	}
+	if r.IncludeDisabled {
+		params["include_disabled"] = "true"
+	}
+	if r.IncludeUsers {
+		params["include_users"] = "true"
+	}
+	if len(r.TeamID) > 0 {
+		params["team_id"] = r.TeamID
+	}
+	return params
+}
+
+type ListUsergroupsResponse struct {
+	APIResponse
+
+	Usergroups []Usergroup `json:"usergroups"`
+}
+
+type ListUsergroupUsersRequest struct {
+	// The encoded ID of the User Group.
+	Usergroup string `json:"usergroup"`
+	// Allow results that involve disabled User Groups.
+	IncludeDisabled bool `json:"include_disabled,omitempty"`
+	// encoded team id where the user group exists, required if org token is used.
+	TeamID string `json:"team_id,omitempty"`
+}
+
+func (r *ListUsergroupUsersRequest) params() map[string]string {
+	params := map[string]string{
+		"usergroup": r.Usergroup,
+	}
+	if r.IncludeDisabled {
+		params["include_disabled"] = "true"
+	}
+	if len(r.TeamID) > 0 {
+		params["team_id"] = r.TeamID
+	}
+	return params
+}
+
+type ListUsergroupUsersResponse struct {
+	APIResponse
+
+	Users []string `json:"users"`
+}
+
+type OpenViewRequest struct {
+	// Exchange a trigger to post to the user.
+	TriggerID string `json:"trigger_id"`
+	// A view payload.
+	View *blockkit.ModalView `json:"view"`
+}
+
+type PushViewRequest struct {
+	// Exchange a trigger to post to the user.
+	TriggerID string `json:"trigger_id"`
+	// A view payload.
+	View *blockkit.ModalView `json:"view"`
+}
+
+type UpdateViewRequest struct {
+	// A view object. This must be a JSON-encoded string.
+	View *blockkit.ModalView `json:"view"`
+	// A unique identifier of the view set by the developer. Either view_id or external_id is required.
+	ExternalID string `json:"external_id,omitempty"`
+	// A string that represents view state to protect against possible race conditions.
+	Hash string `json:"hash,omitempty"`
+	// A unique identifier of the view to be updated. Either view_id or external_id is required.
+	ViewID string `json:"view_id,omitempty"`
+}
+
+type ViewResponse struct {
+	APIResponse
+
+	View ViewObject `json:"view"`
+}
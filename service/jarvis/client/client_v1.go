@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	jarvisv1 "github.com/joyfuldevs/project-jarvis/gen/go/jarvis/v1"
 )
@@ -24,7 +25,7 @@ func (c *Client) SendMessage(
 	message string,
 	blocks []byte,
 	markdown bool,
-) (float64, error) {
+) (string, error) {
 	req := &jarvisv1.SendSlackMessageRequest{
 		ChannelId: channel,
 		Message:   message,
@@ -33,7 +34,7 @@ func (c *Client) SendMessage(
 	}
 	resp, err := c.serviceClient.SendSlackMessage(ctx, req)
 	if err != nil {
-		return 0, err
+		return "", err
 	}
 	return resp.Timestamp, nil
 }
@@ -52,3 +53,122 @@ func (c *Client) GetUserProfile(
 	}
 	return resp.Profile, nil
 }
+
+// 슬랙봇이 보낸 메시지를 수정한다.
+// 수정이 성공하면 메시지의 타임스탬프를 리턴한다.
+func (c *Client) UpdateMessage(
+	ctx context.Context,
+	channel string,
+	timestamp string,
+	message string,
+	blocks []byte,
+) (string, error) {
+	req := &jarvisv1.UpdateSlackMessageRequest{
+		ChannelId: channel,
+		Timestamp: timestamp,
+		Message:   message,
+		Blocks:    blocks,
+	}
+	resp, err := c.serviceClient.UpdateSlackMessage(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Timestamp, nil
+}
+
+// 슬랙봇이 보낸 메시지를 삭제한다.
+func (c *Client) DeleteMessage(
+	ctx context.Context,
+	channel string,
+	timestamp string,
+) error {
+	req := &jarvisv1.DeleteSlackMessageRequest{
+		ChannelId: channel,
+		Timestamp: timestamp,
+	}
+	_, err := c.serviceClient.DeleteSlackMessage(ctx, req)
+	return err
+}
+
+// 채널의 한 사용자에게만 보이는 메시지를 보낸다.
+// threadTimestamp 가 0 이 아니면 해당 스레드에 메시지를 보낸다.
+func (c *Client) SendEphemeralMessage(
+	ctx context.Context,
+	channel string,
+	user string,
+	message string,
+	blocks []byte,
+	threadTimestamp string,
+) (string, error) {
+	req := &jarvisv1.SendEphemeralMessageRequest{
+		ChannelId:       channel,
+		UserId:          user,
+		Message:         message,
+		Blocks:          blocks,
+		ThreadTimestamp: threadTimestamp,
+	}
+	resp, err := c.serviceClient.SendEphemeralMessage(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Timestamp, nil
+}
+
+// 지정한 시각에 슬랙봇이 메시지를 보내도록 예약한다.
+// 예약이 성공하면 예약된 메시지의 ID 를 리턴한다.
+func (c *Client) ScheduleMessage(
+	ctx context.Context,
+	channel string,
+	message string,
+	blocks []byte,
+	markdown bool,
+	postAt time.Time,
+) (string, error) {
+	req := &jarvisv1.ScheduleSlackMessageRequest{
+		ChannelId: channel,
+		Message:   message,
+		Blocks:    blocks,
+		Markdown:  markdown,
+		PostAt:    postAt.Unix(),
+	}
+	resp, err := c.serviceClient.ScheduleSlackMessage(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.ScheduledMessageId, nil
+}
+
+// 워크스페이스의 사용자 목록을 가져온다.
+// name 이 비어있지 않으면 이름이 일치하는 사용자만 가져온다.
+func (c *Client) ListUsers(
+	ctx context.Context,
+	name string,
+	includeBots bool,
+	includeDeleted bool,
+) ([]*jarvisv1.User, error) {
+	req := &jarvisv1.ListUsersRequest{
+		Name:           name,
+		IncludeBots:    includeBots,
+		IncludeDeleted: includeDeleted,
+	}
+	resp, err := c.serviceClient.ListUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// 이메일로 사용자를 찾는다.
+func (c *Client) LookupUserByEmail(
+	ctx context.Context,
+	email string,
+) (*jarvisv1.User, error) {
+	req := &jarvisv1.LookupUserByEmailRequest{
+		Email: email,
+	}
+	resp, err := c.serviceClient.LookupUserByEmail(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
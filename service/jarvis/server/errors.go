@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/joyfuldevs/project-jarvis/pkg/slack"
+	"github.com/joyfuldevs/project-jarvis/pkg/slack/blockkit"
+)
+
+// 슬랙 오류 코드에 대응하는 gRPC 상태 코드.
+var slackErrorCodes = []struct {
+	target error
+	code   codes.Code
+}{
+	{target: slack.ErrChannelNotFound, code: codes.NotFound},
+	{target: slack.ErrNotInChannel, code: codes.FailedPrecondition},
+	{target: slack.ErrInvalidAuth, code: codes.Unauthenticated},
+	{target: slack.ErrRateLimited, code: codes.ResourceExhausted},
+	{target: slack.ErrInvalidBlocks, code: codes.InvalidArgument},
+	{target: slack.ErrUserNotFound, code: codes.NotFound},
+	{target: slack.ErrUsersNotFound, code: codes.NotFound},
+}
+
+// 서비스에서 반환한 오류를 클라이언트가 구분할 수 있도록 gRPC 상태 오류로 변환한다.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		validationErrs blockkit.ValidationErrors
+		syntaxErr      *json.SyntaxError
+		typeErr        *json.UnmarshalTypeError
+		apiErr         *slack.APIError
+	)
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.As(err, &validationErrs), errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, e := range slackErrorCodes {
+		if errors.Is(err, e.target) {
+			return status.Error(e.code, err.Error())
+		}
+	}
+	if errors.As(err, &apiErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"sync/atomic"
 
 	"google.golang.org/grpc"
 
@@ -32,6 +33,8 @@ func WithServiceV1(s ServiceV1) Option {
 
 type Server struct {
 	options *serverOptions
+	// 요청을 받을 수 있는 상태인지 여부.
+	serving atomic.Bool
 }
 
 func NewServer(opts ...Option) *Server {
@@ -61,8 +64,16 @@ func (s *Server) Serve(ctx context.Context) error {
 
 	go func() {
 		<-ctx.Done()
+		s.serving.Store(false)
 		grpcServer.GracefulStop()
 	}()
 
+	s.serving.Store(true)
+	defer s.serving.Store(false)
 	return grpcServer.Serve(listener)
 }
+
+// gRPC 요청을 받을 수 있는 상태인지 반환한다. 준비 상태를 확인할 때 사용한다.
+func (s *Server) Serving() bool {
+	return s.serving.Load()
+}
@@ -8,10 +8,18 @@ import (
 
 type UserProfileV1 = jarvisv1.UserProfile
 
+type UserV1 = jarvisv1.User
+
 type ServiceV1 interface {
 	ListInvitedChannels(ctx context.Context) ([]string, error)
-	SendSlackMessage(ctx context.Context, channel string, message string, blocksData []byte, markdown bool) (float64, error)
+	SendSlackMessage(ctx context.Context, channel string, message string, blocksData []byte, markdown bool) (string, error)
 	GetUserProfile(ctx context.Context, userID string) (*UserProfileV1, error)
+	UpdateSlackMessage(ctx context.Context, channel string, timestamp string, message string, blocksData []byte) (string, error)
+	DeleteSlackMessage(ctx context.Context, channel string, timestamp string) error
+	SendEphemeralMessage(ctx context.Context, channel string, user string, message string, blocksData []byte, threadTimestamp string) (string, error)
+	ScheduleSlackMessage(ctx context.Context, channel string, message string, blocksData []byte, markdown bool, postAt int64) (string, int64, error)
+	ListUsers(ctx context.Context, name string, includeBots bool, includeDeleted bool) ([]*UserV1, error)
+	LookupUserByEmail(ctx context.Context, email string) (*UserV1, error)
 }
 
 type serverV1 struct {
@@ -26,7 +34,7 @@ func (s *serverV1) ListInvitedChannels(
 ) (*jarvisv1.ListInvitedChannelsResponse, error) {
 	channelIds, err := s.service.ListInvitedChannels(ctx)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 	return &jarvisv1.ListInvitedChannelsResponse{
 		ChannelIds: channelIds,
@@ -45,7 +53,7 @@ func (s *serverV1) SendSlackMessage(
 		req.Markdown,
 	)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 	return &jarvisv1.SendSlackMessageResponse{
 		Timestamp: timestamp,
@@ -58,10 +66,106 @@ func (s *serverV1) GetUserProfile(
 ) (*jarvisv1.GetUserProfileResponse, error) {
 	profile, err := s.service.GetUserProfile(ctx, req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 	return &jarvisv1.GetUserProfileResponse{
 		UserId:  req.UserId,
 		Profile: profile,
 	}, nil
 }
+
+func (s *serverV1) UpdateSlackMessage(
+	ctx context.Context,
+	req *jarvisv1.UpdateSlackMessageRequest,
+) (*jarvisv1.UpdateSlackMessageResponse, error) {
+	timestamp, err := s.service.UpdateSlackMessage(
+		ctx,
+		req.ChannelId,
+		req.Timestamp,
+		req.Message,
+		req.Blocks,
+	)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.UpdateSlackMessageResponse{
+		Timestamp: timestamp,
+	}, nil
+}
+
+func (s *serverV1) DeleteSlackMessage(
+	ctx context.Context,
+	req *jarvisv1.DeleteSlackMessageRequest,
+) (*jarvisv1.DeleteSlackMessageResponse, error) {
+	if err := s.service.DeleteSlackMessage(ctx, req.ChannelId, req.Timestamp); err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.DeleteSlackMessageResponse{}, nil
+}
+
+func (s *serverV1) SendEphemeralMessage(
+	ctx context.Context,
+	req *jarvisv1.SendEphemeralMessageRequest,
+) (*jarvisv1.SendEphemeralMessageResponse, error) {
+	timestamp, err := s.service.SendEphemeralMessage(
+		ctx,
+		req.ChannelId,
+		req.UserId,
+		req.Message,
+		req.Blocks,
+		req.ThreadTimestamp,
+	)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.SendEphemeralMessageResponse{
+		Timestamp: timestamp,
+	}, nil
+}
+
+func (s *serverV1) ScheduleSlackMessage(
+	ctx context.Context,
+	req *jarvisv1.ScheduleSlackMessageRequest,
+) (*jarvisv1.ScheduleSlackMessageResponse, error) {
+	scheduledMessageID, postAt, err := s.service.ScheduleSlackMessage(
+		ctx,
+		req.ChannelId,
+		req.Message,
+		req.Blocks,
+		req.Markdown,
+		req.PostAt,
+	)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.ScheduleSlackMessageResponse{
+		ScheduledMessageId: scheduledMessageID,
+		PostAt:             postAt,
+	}, nil
+}
+
+func (s *serverV1) ListUsers(
+	ctx context.Context,
+	req *jarvisv1.ListUsersRequest,
+) (*jarvisv1.ListUsersResponse, error) {
+	users, err := s.service.ListUsers(ctx, req.Name, req.IncludeBots, req.IncludeDeleted)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.ListUsersResponse{
+		Users: users,
+	}, nil
+}
+
+func (s *serverV1) LookupUserByEmail(
+	ctx context.Context,
+	req *jarvisv1.LookupUserByEmailRequest,
+) (*jarvisv1.LookupUserByEmailResponse, error) {
+	user, err := s.service.LookupUserByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &jarvisv1.LookupUserByEmailResponse{
+		User: user,
+	}, nil
+}